@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/galaxy-io/tempo/internal/config"
+)
+
+// runCommand runs a non-interactive subcommand and returns the process exit code.
+func runCommand(args []string) int {
+	switch {
+	case len(args) >= 2 && args[0] == "config" && args[1] == "import":
+		return runConfigImport(args[2:])
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown command %q\n", strings.Join(args, " "))
+		fmt.Fprintln(os.Stderr, "Available commands:")
+		fmt.Fprintln(os.Stderr, "  config import    Import profiles from the temporal CLI env config")
+		fmt.Fprintln(os.Stderr, "  open <file>      Browse an exported workflow history JSON file offline")
+		return 2
+	}
+}
+
+// runConfigImport copies temporal CLI env config profiles into the tempo config.
+func runConfigImport(args []string) int {
+	fs := flag.NewFlagSet("tempo config import", flag.ContinueOnError)
+	file := fs.String("file", config.TemporalCLIConfigPath(), "temporal CLI env config file")
+	overwrite := fs.Bool("overwrite", false, "Replace existing tempo profiles with the same name")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	profiles, warnings, err := config.LoadTemporalCLIProfiles(*file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if len(profiles) == 0 {
+		fmt.Fprintf(os.Stderr, "No profiles found in %s\n", *file)
+		return 1
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	imported, skipped := cfg.ImportProfiles(profiles, *overwrite)
+	if len(imported) > 0 {
+		if err := cfg.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	for _, w := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+	}
+	for _, name := range imported {
+		fmt.Printf("Imported profile %q\n", name)
+	}
+	for _, name := range skipped {
+		fmt.Printf("Skipped profile %q (already exists, use -overwrite to replace)\n", name)
+	}
+	fmt.Printf("%d imported, %d skipped from %s\n", len(imported), len(skipped), *file)
+	return 0
+}
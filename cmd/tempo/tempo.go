@@ -12,6 +12,8 @@ import (
 	"github.com/atterpac/jig/theme/themes"
 	"github.com/atterpac/jig/util"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/mock"
+	"github.com/galaxy-io/tempo/internal/session"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/galaxy-io/tempo/internal/view"
@@ -29,8 +31,18 @@ var (
 	tlsCA         = flag.String("tls-ca", "", "Path to CA certificate (overrides profile)")
 	tlsServerName = flag.String("tls-server-name", "", "Server name for TLS verification (overrides profile)")
 	tlsSkipVerify = flag.Bool("tls-skip-verify", false, "Skip TLS verification (insecure)")
+	codecEndpoint = flag.String("codec-endpoint", "", "Remote codec server URL for decoding payloads (overrides profile)")
+	protoDescs    = flag.String("proto-descriptors", "", "Comma-separated FileDescriptorSet files for decoding protobuf payloads (overrides profile)")
+	apiKey        = flag.String("api-key", "", "API key for authentication (overrides profile)")
+	apiKeyEnv     = flag.String("api-key-env", "", "Environment variable holding the API key (overrides profile)")
+	apiKeyCommand = flag.String("api-key-command", "", "Command whose output is the API key (overrides profile)")
+	headers       = flag.String("headers", "", "Comma-separated key=value gRPC headers, merged over the profile's")
 	themeNameFlag = flag.String("theme", "", "Theme name (overrides config file)")
 	devMode       = flag.Bool("dev", false, "Development mode: test splash screen with theme cycling")
+	mockMode      = flag.Bool("mock", false, "Use an in-memory mock server instead of connecting to Temporal")
+	mockFixture   = flag.String("mock-fixture", "", "JSON fixture to seed the mock server (implies --mock)")
+	recordFile    = flag.String("record", "", "Record every server call and result to a session file")
+	replayFile    = flag.String("replay", "", "Replay a recorded session file instead of connecting")
 	versionFlag   = flag.Bool("version", false, "Print version information and exit")
 )
 
@@ -49,6 +61,19 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Non-interactive subcommands, e.g. `tempo config import`; `tempo open` runs the UI
+	var historyPath string
+	if flag.NArg() > 0 {
+		if flag.Arg(0) != "open" {
+			os.Exit(runCommand(flag.Args()))
+		}
+		if flag.NArg() != 2 {
+			fmt.Fprintln(os.Stderr, "Usage: tempo open <history.json>")
+			os.Exit(2)
+		}
+		historyPath = flag.Arg(1)
+	}
+
 	// Load configuration from file
 	cfg, err := config.Load()
 	if err != nil {
@@ -89,6 +114,28 @@ func main() {
 	// Get the profile's connection config
 	profileConfig, _ := cfg.GetProfile(activeProfileName)
 
+	// TEMPORAL_PROFILE picks a tempo profile when no flag is given, falling back to
+	// the temporal CLI config for profiles that were never imported
+	if envProfile := os.Getenv("TEMPORAL_PROFILE"); envProfile != "" && *profileName == "" {
+		if cfg.ProfileExists(envProfile) {
+			profileConfig, _ = cfg.GetProfile(envProfile)
+		} else {
+			cliProfile, warnings, err := config.LoadTemporalCLIProfile(envProfile)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: TEMPORAL_PROFILE: %v\n", err)
+				os.Exit(1)
+			}
+			for _, w := range warnings {
+				fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+			}
+			profileConfig = cliProfile
+		}
+		activeProfileName = envProfile
+	}
+
+	// TEMPORAL_* environment variables override the profile; flags override both
+	profileConfig = config.ApplyTemporalEnv(profileConfig)
+
 	// Build temporal connection config from profile
 	connConfig := temporal.ConnectionConfig{
 		Address:       profileConfig.Address,
@@ -98,6 +145,15 @@ func main() {
 		TLSCAPath:     profileConfig.TLS.CA,
 		TLSServerName: profileConfig.TLS.ServerName,
 		TLSSkipVerify: profileConfig.TLS.SkipVerify,
+		TLSEnabled:    profileConfig.TLS.Enabled,
+		CodecEndpoint: profileConfig.CodecEndpoint,
+
+		ProtoDescriptorPaths: profileConfig.ProtoDescriptors,
+
+		APIKey:        profileConfig.APIKey,
+		APIKeyEnv:     profileConfig.APIKeyEnv,
+		APIKeyCommand: profileConfig.APIKeyCommand,
+		Headers:       profileConfig.Headers,
 	}
 
 	// CLI flags override profile settings
@@ -122,24 +178,96 @@ func main() {
 	if *tlsSkipVerify {
 		connConfig.TLSSkipVerify = true
 	}
+	if *codecEndpoint != "" {
+		connConfig.CodecEndpoint = *codecEndpoint
+	}
+	if *protoDescs != "" {
+		connConfig.ProtoDescriptorPaths = config.SplitList(*protoDescs)
+	}
+	// An API key flag replaces whatever source the profile uses
+	if *apiKey != "" || *apiKeyEnv != "" || *apiKeyCommand != "" {
+		connConfig.APIKey = *apiKey
+		connConfig.APIKeyEnv = *apiKeyEnv
+		connConfig.APIKeyCommand = *apiKeyCommand
+	}
+	if *headers != "" {
+		flagHeaders, err := config.ParseHeaders(*headers)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		merged := make(map[string]string)
+		for k, v := range connConfig.Headers {
+			merged[k] = v
+		}
+		for k, v := range flagHeaders {
+			merged[k] = v
+		}
+		connConfig.Headers = merged
+	}
 
-	// Run connection with UI
-	provider, err := connectWithUI(connConfig)
+	// Mock and replay modes serve everything locally; otherwise connect with UI
+	var provider temporal.Provider
+	switch {
+	case historyPath != "":
+		// The app serves the file itself once it is built
+		activeProfileName = "history-file"
+	case *replayFile != "":
+		provider, err = newReplayProvider(*replayFile)
+		activeProfileName = "replay"
+	case *mockMode || *mockFixture != "":
+		provider, err = newMockProvider(*mockFixture)
+		activeProfileName = "mock"
+	default:
+		provider, err = connectWithUI(connConfig)
+	}
+	if err == nil && provider != nil && *recordFile != "" {
+		provider, err = session.NewRecorder(provider, *recordFile)
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
-	defer provider.Close()
+	if provider != nil {
+		defer provider.Close()
+	}
+
+	if (*replayFile != "" || *mockMode || *mockFixture != "") && *namespace == "" {
+		connConfig.Namespace = provider.Config().Namespace
+	}
 
 	// Launch main application with config for profile management
 	app := view.NewAppWithProvider(provider, connConfig.Namespace, cfg, activeProfileName)
 	app.SetDevMode(*devMode)
+	if historyPath != "" {
+		if err := app.OpenHistoryFile(historyPath); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
 	if err := app.Run(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
 }
 
+// newMockProvider creates an in-memory provider, seeded from fixturePath when given.
+func newMockProvider(fixturePath string) (temporal.Provider, error) {
+	if fixturePath == "" {
+		return mock.NewProvider(nil), nil
+	}
+	fixture, err := mock.LoadFixture(fixturePath)
+	if err != nil {
+		return nil, err
+	}
+	return mock.NewProvider(fixture), nil
+}
+
+// newReplayProvider creates a provider that serves a recorded session.
+func newReplayProvider(path string) (temporal.Provider, error) {
+	return session.NewPlayer(path)
+}
+
 const splashLogo = `
 ░▒▓████████▓▒░▒▓████████▓▒░▒▓██████████████▓▒░░▒▓███████▓▒░ ░▒▓██████▓▒░  
    ░▒▓█▓▒░   ░▒▓█▓▒░      ░▒▓█▓▒░░▒▓█▓▒░░▒▓█▓▒░▒▓█▓▒░░▒▓█▓▒░▒▓█▓▒░░▒▓█▓▒░ 
@@ -3,12 +3,15 @@ module github.com/galaxy-io/tempo
 go 1.25.2
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/atterpac/jig v0.0.4
 	github.com/creativeprojects/go-selfupdate v1.5.2
 	github.com/gdamore/tcell/v2 v2.13.4
+	github.com/google/uuid v1.6.0
 	github.com/rivo/tview v0.42.0
 	go.temporal.io/api v1.59.0
 	go.temporal.io/sdk v1.38.0
+	google.golang.org/grpc v1.71.0
 	google.golang.org/protobuf v1.36.11
 	gopkg.in/yaml.v3 v3.0.1
 )
@@ -28,7 +31,6 @@ require (
 	github.com/golang/mock v1.6.0 // indirect
 	github.com/google/go-github/v74 v74.0.0 // indirect
 	github.com/google/go-querystring v1.1.0 // indirect
-	github.com/google/uuid v1.6.0 // indirect
 	github.com/grpc-ecosystem/go-grpc-middleware/v2 v2.3.2 // indirect
 	github.com/grpc-ecosystem/grpc-gateway/v2 v2.22.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
@@ -53,5 +55,4 @@ require (
 	golang.org/x/time v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250811230008-5f3141c8851a // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250811230008-5f3141c8851a // indirect
-	google.golang.org/grpc v1.71.0 // indirect
 )
@@ -12,6 +12,7 @@ import (
 
 // TLSConfig holds TLS connection settings.
 type TLSConfig struct {
+	Enabled    bool   `yaml:"enabled,omitempty"` // Use TLS even without certificates, trusting system roots
 	Cert       string `yaml:"cert,omitempty"`
 	Key        string `yaml:"key,omitempty"`
 	CA         string `yaml:"ca,omitempty"`
@@ -21,9 +22,19 @@ type TLSConfig struct {
 
 // ConnectionConfig holds Temporal connection settings.
 type ConnectionConfig struct {
-	Address   string    `yaml:"address"`
-	Namespace string    `yaml:"namespace"`
-	TLS       TLSConfig `yaml:"tls,omitempty"`
+	Address       string    `yaml:"address"`
+	Namespace     string    `yaml:"namespace"`
+	TLS           TLSConfig `yaml:"tls,omitempty"`
+	CodecEndpoint string    `yaml:"codec_endpoint,omitempty"` // Remote codec server for encrypted/compressed payloads
+
+	ProtoDescriptors []string `yaml:"proto_descriptors,omitempty"` // FileDescriptorSet files for protobuf payloads
+
+	// API key authentication; set one of these. The first non-empty source wins.
+	APIKey        string `yaml:"api_key,omitempty"`         // Literal API key
+	APIKeyEnv     string `yaml:"api_key_env,omitempty"`     // Environment variable holding the API key
+	APIKeyCommand string `yaml:"api_key_command,omitempty"` // Command whose stdout is the API key
+
+	Headers map[string]string `yaml:"headers,omitempty"` // Static gRPC metadata sent with every request
 }
 
 // ToTemporalConfig converts config.ConnectionConfig to temporal-compatible format.
@@ -47,6 +58,50 @@ func FromTemporalConfig(address, namespace, tlsCert, tlsKey, tlsCA, tlsServerNam
 	}
 }
 
+// SplitList splits a comma-separated list, trimming whitespace and dropping empty entries.
+func SplitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ParseHeaders parses comma-separated key=value pairs into gRPC metadata.
+// Keys are lowercased since gRPC metadata keys are case-insensitive.
+func ParseHeaders(s string) (map[string]string, error) {
+	var headers map[string]string
+	for _, item := range SplitList(s) {
+		key, value, ok := strings.Cut(item, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid header %q, expected key=value", item)
+		}
+		if headers == nil {
+			headers = make(map[string]string)
+		}
+		headers[key] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
+
+// FormatHeaders formats gRPC metadata as comma-separated key=value pairs, sorted by key.
+func FormatHeaders(headers map[string]string) string {
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + headers[key]
+	}
+	return strings.Join(pairs, ", ")
+}
+
 // SavedFilter represents a saved visibility query.
 type SavedFilter struct {
 	Name      string `yaml:"name"`
@@ -0,0 +1,230 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// temporalCLIConfig mirrors the temporal CLI's TOML env config file.
+type temporalCLIConfig struct {
+	Profiles map[string]temporalCLIProfile `toml:"profile"`
+}
+
+// temporalCLIProfile is a single [profile.<name>] table.
+type temporalCLIProfile struct {
+	Address   string            `toml:"address"`
+	Namespace string            `toml:"namespace"`
+	APIKey    string            `toml:"api_key"`
+	TLS       *temporalCLITLS   `toml:"tls"`
+	Codec     *temporalCLICodec `toml:"codec"`
+	GRPCMeta  map[string]string `toml:"grpc_meta"`
+}
+
+type temporalCLITLS struct {
+	Disabled                bool   `toml:"disabled"`
+	ClientCertPath          string `toml:"client_cert_path"`
+	ClientCertData          string `toml:"client_cert_data"`
+	ClientKeyPath           string `toml:"client_key_path"`
+	ClientKeyData           string `toml:"client_key_data"`
+	ServerCACertPath        string `toml:"server_ca_cert_path"`
+	ServerCACertData        string `toml:"server_ca_cert_data"`
+	ServerName              string `toml:"server_name"`
+	DisableHostVerification bool   `toml:"disable_host_verification"`
+}
+
+type temporalCLICodec struct {
+	Endpoint string `toml:"endpoint"`
+	Auth     string `toml:"auth"`
+}
+
+// TemporalCLIConfigPath returns the temporal CLI env config file path.
+// TEMPORAL_CONFIG_FILE overrides the default <user config dir>/temporalio/temporal.toml.
+func TemporalCLIConfigPath() string {
+	if path := os.Getenv("TEMPORAL_CONFIG_FILE"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return filepath.Join(".temporalio", "temporal.toml")
+	}
+	return filepath.Join(dir, "temporalio", "temporal.toml")
+}
+
+// LoadTemporalCLIProfiles reads profiles from a temporal CLI env config file.
+// Settings tempo cannot represent are reported as warnings and left out.
+func LoadTemporalCLIProfiles(path string) (map[string]ConnectionConfig, []string, error) {
+	var file temporalCLIConfig
+	if _, err := toml.DecodeFile(path, &file); err != nil {
+		return nil, nil, fmt.Errorf("parsing temporal CLI config: %w", err)
+	}
+
+	names := make([]string, 0, len(file.Profiles))
+	for name := range file.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	profiles := make(map[string]ConnectionConfig, len(names))
+	var warnings []string
+	for _, name := range names {
+		cfg, profileWarnings := file.Profiles[name].toConnectionConfig()
+		for _, w := range profileWarnings {
+			warnings = append(warnings, fmt.Sprintf("profile %q: %s", name, w))
+		}
+		profiles[name] = cfg
+	}
+	return profiles, warnings, nil
+}
+
+// LoadTemporalCLIProfile reads one named profile from the temporal CLI env config file.
+func LoadTemporalCLIProfile(name string) (ConnectionConfig, []string, error) {
+	path := TemporalCLIConfigPath()
+	profiles, warnings, err := LoadTemporalCLIProfiles(path)
+	if err != nil {
+		return ConnectionConfig{}, nil, err
+	}
+	cfg, ok := profiles[name]
+	if !ok {
+		return ConnectionConfig{}, nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	prefix := fmt.Sprintf("profile %q: ", name)
+	var profileWarnings []string
+	for _, w := range warnings {
+		if strings.HasPrefix(w, prefix) {
+			profileWarnings = append(profileWarnings, w)
+		}
+	}
+	return cfg, profileWarnings, nil
+}
+
+// toConnectionConfig converts a temporal CLI profile, returning any settings that were dropped.
+func (p temporalCLIProfile) toConnectionConfig() (ConnectionConfig, []string) {
+	cfg := ConnectionConfig{
+		Address:   p.Address,
+		Namespace: p.Namespace,
+		APIKey:    p.APIKey,
+	}
+	if cfg.Address == "" {
+		cfg.Address = "localhost:7233"
+	}
+	if cfg.Namespace == "" {
+		cfg.Namespace = "default"
+	}
+
+	var warnings []string
+	if p.TLS != nil && !p.TLS.Disabled {
+		// A [tls] table turns TLS on even without certificates, e.g. an API key
+		// against a server with a publicly trusted certificate.
+		cfg.TLS = TLSConfig{
+			Enabled:    true,
+			Cert:       p.TLS.ClientCertPath,
+			Key:        p.TLS.ClientKeyPath,
+			CA:         p.TLS.ServerCACertPath,
+			ServerName: p.TLS.ServerName,
+			SkipVerify: p.TLS.DisableHostVerification,
+		}
+		if p.TLS.ClientCertData != "" || p.TLS.ClientKeyData != "" || p.TLS.ServerCACertData != "" {
+			warnings = append(warnings, "inline TLS certificate data is not supported, use *_path settings instead")
+		}
+	}
+	if p.Codec != nil {
+		cfg.CodecEndpoint = p.Codec.Endpoint
+		if p.Codec.Auth != "" {
+			warnings = append(warnings, "codec auth is not supported")
+		}
+	}
+	if len(p.GRPCMeta) > 0 {
+		cfg.Headers = make(map[string]string, len(p.GRPCMeta))
+		for key, value := range p.GRPCMeta {
+			cfg.Headers[strings.ToLower(key)] = value
+		}
+	}
+	return cfg, warnings
+}
+
+// ImportProfiles adds profiles to the config. Existing profiles are kept unless
+// overwrite is set. Returns the names that were imported and skipped, sorted.
+func (c *Config) ImportProfiles(profiles map[string]ConnectionConfig, overwrite bool) (imported, skipped []string) {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if c.ProfileExists(name) && !overwrite {
+			skipped = append(skipped, name)
+			continue
+		}
+		c.SaveProfile(name, profiles[name])
+		imported = append(imported, name)
+	}
+	return imported, skipped
+}
+
+// ApplyTemporalEnv overrides profile settings with the standard TEMPORAL_*
+// environment variables used by the temporal CLI and SDKs.
+func ApplyTemporalEnv(cfg ConnectionConfig) ConnectionConfig {
+	setFromEnv(&cfg.Address, "TEMPORAL_ADDRESS")
+	setFromEnv(&cfg.Namespace, "TEMPORAL_NAMESPACE")
+	setFromEnv(&cfg.TLS.Cert, "TEMPORAL_TLS_CERT", "TEMPORAL_TLS_CLIENT_CERT_PATH")
+	setFromEnv(&cfg.TLS.Key, "TEMPORAL_TLS_KEY", "TEMPORAL_TLS_CLIENT_KEY_PATH")
+	setFromEnv(&cfg.TLS.CA, "TEMPORAL_TLS_CA", "TEMPORAL_TLS_SERVER_CA_CERT_PATH")
+	setFromEnv(&cfg.TLS.ServerName, "TEMPORAL_TLS_SERVER_NAME")
+	setFromEnv(&cfg.CodecEndpoint, "TEMPORAL_CODEC_ENDPOINT")
+
+	if v := os.Getenv("TEMPORAL_TLS_DISABLE_HOST_VERIFICATION"); v != "" {
+		if skip, err := strconv.ParseBool(v); err == nil {
+			cfg.TLS.SkipVerify = skip
+		}
+	}
+	// TEMPORAL_TLS=false turns TLS off entirely, overriding the settings above
+	if v := os.Getenv("TEMPORAL_TLS"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil {
+			if !enabled {
+				cfg.TLS = TLSConfig{}
+			}
+			cfg.TLS.Enabled = enabled
+		}
+	}
+
+	// An API key from the environment replaces whatever source the profile uses
+	if key := os.Getenv("TEMPORAL_API_KEY"); key != "" {
+		cfg.APIKey = key
+		cfg.APIKeyEnv = ""
+		cfg.APIKeyCommand = ""
+	}
+
+	// TEMPORAL_GRPC_META_X_TENANT=a sends the header x-tenant: a
+	const metaPrefix = "TEMPORAL_GRPC_META_"
+	for _, kv := range os.Environ() {
+		key, value, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(key, metaPrefix) || len(key) == len(metaPrefix) {
+			continue
+		}
+		headers := make(map[string]string, len(cfg.Headers)+1)
+		for k, v := range cfg.Headers {
+			headers[k] = v
+		}
+		headers[strings.ToLower(strings.ReplaceAll(key[len(metaPrefix):], "_", "-"))] = value
+		cfg.Headers = headers
+	}
+
+	return cfg
+}
+
+// setFromEnv sets *field from the first non-empty environment variable.
+func setFromEnv(field *string, names ...string) {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			*field = v
+			return
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+const testTemporalCLIConfig = `
+[profile.default]
+address = "localhost:7233"
+
+[profile.cloud]
+address = "orders.a1b2c.tmprl.cloud:7233"
+namespace = "orders.a1b2c"
+api_key = "secret"
+
+[profile.cloud.tls]
+
+[profile.cloud.grpc_meta]
+X-Tenant = "acme"
+
+[profile.mtls]
+address = "temporal.internal:7233"
+namespace = "payments"
+
+[profile.mtls.tls]
+client_cert_path = "/certs/client.pem"
+client_key_path = "/certs/client.key"
+server_ca_cert_path = "/certs/ca.pem"
+server_name = "temporal.internal"
+disable_host_verification = true
+
+[profile.mtls.codec]
+endpoint = "https://codec.internal"
+
+[profile.inline]
+address = "temporal.internal:7233"
+
+[profile.inline.tls]
+client_cert_data = "-----BEGIN CERTIFICATE-----"
+
+[profile.inline.codec]
+endpoint = "https://codec.internal"
+auth = "Bearer token"
+
+[profile.plaintext]
+address = "temporal.internal:7233"
+
+[profile.plaintext.tls]
+disabled = true
+client_cert_path = "/certs/client.pem"
+`
+
+// writeTemporalCLIConfig writes a temporal CLI config file and returns its path.
+func writeTemporalCLIConfig(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "temporal.toml")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadTemporalCLIProfiles(t *testing.T) {
+	profiles, warnings, err := LoadTemporalCLIProfiles(writeTemporalCLIConfig(t, testTemporalCLIConfig))
+	if err != nil {
+		t.Fatalf("LoadTemporalCLIProfiles: %v", err)
+	}
+
+	want := map[string]ConnectionConfig{
+		"default": {Address: "localhost:7233", Namespace: "default"},
+		"cloud": {
+			Address:   "orders.a1b2c.tmprl.cloud:7233",
+			Namespace: "orders.a1b2c",
+			APIKey:    "secret",
+			TLS:       TLSConfig{Enabled: true},
+			Headers:   map[string]string{"x-tenant": "acme"},
+		},
+		"mtls": {
+			Address:   "temporal.internal:7233",
+			Namespace: "payments",
+			TLS: TLSConfig{
+				Enabled:    true,
+				Cert:       "/certs/client.pem",
+				Key:        "/certs/client.key",
+				CA:         "/certs/ca.pem",
+				ServerName: "temporal.internal",
+				SkipVerify: true,
+			},
+			CodecEndpoint: "https://codec.internal",
+		},
+		"inline": {
+			Address:       "temporal.internal:7233",
+			Namespace:     "default",
+			TLS:           TLSConfig{Enabled: true},
+			CodecEndpoint: "https://codec.internal",
+		},
+		"plaintext": {Address: "temporal.internal:7233", Namespace: "default"},
+	}
+	for name, wantCfg := range want {
+		if got := profiles[name]; !reflect.DeepEqual(got, wantCfg) {
+			t.Errorf("profile %q = %+v, want %+v", name, got, wantCfg)
+		}
+	}
+	if len(profiles) != len(want) {
+		t.Errorf("got %d profiles, want %d", len(profiles), len(want))
+	}
+
+	wantWarnings := []string{
+		`profile "inline": inline TLS certificate data is not supported, use *_path settings instead`,
+		`profile "inline": codec auth is not supported`,
+	}
+	if !slices.Equal(warnings, wantWarnings) {
+		t.Errorf("warnings = %q, want %q", warnings, wantWarnings)
+	}
+}
+
+func TestLoadTemporalCLIProfile(t *testing.T) {
+	t.Setenv("TEMPORAL_CONFIG_FILE", writeTemporalCLIConfig(t, testTemporalCLIConfig))
+
+	cfg, warnings, err := LoadTemporalCLIProfile("inline")
+	if err != nil {
+		t.Fatalf("LoadTemporalCLIProfile: %v", err)
+	}
+	if cfg.Address != "temporal.internal:7233" || len(warnings) != 2 {
+		t.Errorf("LoadTemporalCLIProfile(inline) = %+v, %q", cfg, warnings)
+	}
+	if _, warnings, _ := LoadTemporalCLIProfile("cloud"); len(warnings) != 0 {
+		t.Errorf("cloud picked up another profile's warnings: %q", warnings)
+	}
+	if _, _, err := LoadTemporalCLIProfile("missing"); err == nil {
+		t.Error("LoadTemporalCLIProfile(missing) succeeded")
+	}
+}
+
+func TestLoadTemporalCLIProfilesInvalid(t *testing.T) {
+	if _, _, err := LoadTemporalCLIProfiles(writeTemporalCLIConfig(t, "[profile.default\n")); err == nil {
+		t.Error("LoadTemporalCLIProfiles accepted invalid TOML")
+	}
+}
+
+func TestImportProfiles(t *testing.T) {
+	c := &Config{Profiles: map[string]ConnectionConfig{
+		"local": {Address: "localhost:7233", Namespace: "default"},
+	}}
+	incoming := map[string]ConnectionConfig{
+		"local": {Address: "temporal.internal:7233", Namespace: "orders"},
+		"cloud": {Address: "orders.a1b2c.tmprl.cloud:7233", Namespace: "orders.a1b2c"},
+	}
+
+	imported, skipped := c.ImportProfiles(incoming, false)
+	if !slices.Equal(imported, []string{"cloud"}) || !slices.Equal(skipped, []string{"local"}) {
+		t.Errorf("ImportProfiles = %q, %q; want [cloud], [local]", imported, skipped)
+	}
+	if c.Profiles["local"].Address != "localhost:7233" {
+		t.Error("an existing profile was overwritten without overwrite")
+	}
+
+	imported, skipped = c.ImportProfiles(incoming, true)
+	if !slices.Equal(imported, []string{"cloud", "local"}) || len(skipped) != 0 {
+		t.Errorf("ImportProfiles with overwrite = %q, %q", imported, skipped)
+	}
+	if c.Profiles["local"].Address != "temporal.internal:7233" {
+		t.Error("overwrite didn't replace the existing profile")
+	}
+}
+
+func TestApplyTemporalEnv(t *testing.T) {
+	file := ConnectionConfig{
+		Address:       "localhost:7233",
+		Namespace:     "default",
+		TLS:           TLSConfig{Enabled: true, Cert: "/file/client.pem", Key: "/file/client.key", CA: "/file/ca.pem"},
+		APIKeyCommand: "pass show temporal",
+		Headers:       map[string]string{"x-tenant": "file"},
+	}
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want ConnectionConfig
+	}{
+		{
+			name: "no environment keeps the file",
+			want: file,
+		},
+		{
+			name: "environment wins over the file",
+			env: map[string]string{
+				"TEMPORAL_ADDRESS":                       "temporal.internal:7233",
+				"TEMPORAL_NAMESPACE":                     "orders",
+				"TEMPORAL_TLS_CLIENT_CERT_PATH":          "/env/client.pem",
+				"TEMPORAL_TLS_SERVER_NAME":               "temporal.internal",
+				"TEMPORAL_TLS_DISABLE_HOST_VERIFICATION": "true",
+				"TEMPORAL_CODEC_ENDPOINT":                "https://codec.internal",
+				"TEMPORAL_API_KEY":                       "env-key",
+				"TEMPORAL_GRPC_META_X_TENANT":            "env",
+				"TEMPORAL_GRPC_META_X_REGION":            "eu",
+			},
+			want: ConnectionConfig{
+				Address:       "temporal.internal:7233",
+				Namespace:     "orders",
+				TLS:           TLSConfig{Enabled: true, Cert: "/env/client.pem", Key: "/file/client.key", CA: "/file/ca.pem", ServerName: "temporal.internal", SkipVerify: true},
+				CodecEndpoint: "https://codec.internal",
+				APIKey:        "env-key",
+				Headers:       map[string]string{"x-tenant": "env", "x-region": "eu"},
+			},
+		},
+		{
+			name: "short TLS names",
+			env:  map[string]string{"TEMPORAL_TLS_CERT": "/env/client.pem", "TEMPORAL_TLS_KEY": "/env/client.key", "TEMPORAL_TLS_CA": "/env/ca.pem"},
+			want: func() ConnectionConfig {
+				cfg := file
+				cfg.TLS = TLSConfig{Enabled: true, Cert: "/env/client.pem", Key: "/env/client.key", CA: "/env/ca.pem"}
+				return cfg
+			}(),
+		},
+		{
+			name: "TEMPORAL_TLS=false turns TLS off",
+			env:  map[string]string{"TEMPORAL_TLS": "false", "TEMPORAL_TLS_CA": "/env/ca.pem"},
+			want: func() ConnectionConfig {
+				cfg := file
+				cfg.TLS = TLSConfig{}
+				return cfg
+			}(),
+		},
+		{
+			name: "TEMPORAL_TLS=true turns TLS on",
+			env:  map[string]string{"TEMPORAL_TLS": "true"},
+			want: file,
+		},
+		{
+			name: "unparseable booleans are ignored",
+			env:  map[string]string{"TEMPORAL_TLS": "maybe", "TEMPORAL_TLS_DISABLE_HOST_VERIFICATION": "sometimes"},
+			want: file,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			if got := ApplyTemporalEnv(file); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplyTemporalEnv = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("TEMPORAL_TLS=true without a file setting", func(t *testing.T) {
+		t.Setenv("TEMPORAL_TLS", "true")
+		if got := ApplyTemporalEnv(ConnectionConfig{Address: "localhost:7233"}); !got.TLS.Enabled {
+			t.Error("TLS not enabled")
+		}
+	})
+	if file.Headers["x-tenant"] != "file" {
+		t.Error("ApplyTemporalEnv modified the profile's headers in place")
+	}
+}
@@ -0,0 +1,426 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// Fixture is the seed data for a mock Provider.
+// Fixture files are JSON; field names match the temporal types case-insensitively,
+// so {"id": "...", "runId": "...", "startTime": "2025-01-02T15:04:05Z"} decodes into a workflow.
+type Fixture struct {
+	Namespaces []NamespaceFixture `json:"namespaces"`
+}
+
+// NamespaceFixture seeds a single namespace and everything that lives in it.
+type NamespaceFixture struct {
+	temporal.NamespaceDetail
+	Workflows       []WorkflowFixture         `json:"workflows"`
+	Schedules       []temporal.Schedule       `json:"schedules"`
+	TaskQueues      []TaskQueueFixture        `json:"taskQueues"`
+	BatchOperations []temporal.BatchOperation `json:"batchOperations"`
+
+	// Custom search attribute types by name. Attributes set on the fixture's
+	// workflows are registered with their own type as well.
+	SearchAttributes map[string]string `json:"searchAttributes"`
+}
+
+// WorkflowFixture seeds a workflow execution.
+// When History is empty a plausible history is generated from the workflow status.
+type WorkflowFixture struct {
+	temporal.Workflow
+	History []temporal.EnhancedHistoryEvent `json:"history"`
+	// Queries maps a query type to the JSON result returned by QueryWorkflow.
+	Queries map[string]json.RawMessage `json:"queries"`
+}
+
+// TaskQueueFixture seeds a task queue with its backlog and pollers.
+type TaskQueueFixture struct {
+	Name    string            `json:"name"`
+	Backlog int               `json:"backlog"`
+	Pollers []temporal.Poller `json:"pollers"`
+}
+
+// LoadFixture reads a fixture from a JSON file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixture: %w", err)
+	}
+
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse fixture: %w", err)
+	}
+	return &f, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// DefaultFixture returns the built-in demo data set, with times relative to now.
+func DefaultFixture() *Fixture {
+	now := time.Now()
+
+	namespaces := []struct {
+		name, state, retention string
+	}{
+		{"default", temporal.NamespaceStateActive, "7 days"},
+		{"production", temporal.NamespaceStateActive, "30 days"},
+		{"staging", temporal.NamespaceStateActive, "3 days"},
+		{"development", temporal.NamespaceStateActive, "1 day"},
+		{"archived", temporal.NamespaceStateDeprecated, "90 days"},
+	}
+
+	f := &Fixture{}
+	for i, ns := range namespaces {
+		nf := NamespaceFixture{
+			NamespaceDetail: temporal.NamespaceDetail{
+				Namespace: temporal.Namespace{
+					Name:            ns.name,
+					State:           ns.state,
+					RetentionPeriod: ns.retention,
+					Description:     fmt.Sprintf("Mock %s namespace", ns.name),
+					OwnerEmail:      "dev@example.com",
+				},
+				ID:                 fmt.Sprintf("mock-namespace-%04d", i+1),
+				CreatedAt:          now.Add(-90 * 24 * time.Hour),
+				UpdatedAt:          now.Add(-24 * time.Hour),
+				HistoryArchival:    "Disabled",
+				VisibilityArchival: "Disabled",
+				Clusters:           []string{"active"},
+			},
+		}
+		if ns.state == temporal.NamespaceStateActive {
+			nf.Workflows = defaultWorkflows(now)
+			nf.Schedules = defaultSchedules(now)
+			nf.TaskQueues = defaultTaskQueues(now)
+			nf.BatchOperations = defaultBatchOperations(now)
+			// Registered but not yet set on any workflow
+			nf.SearchAttributes = map[string]string{"Region": temporal.SearchAttributeKeyword}
+		}
+		f.Namespaces = append(f.Namespaces, nf)
+	}
+	return f
+}
+
+func defaultWorkflows(now time.Time) []WorkflowFixture {
+	workflows := []temporal.Workflow{
+		{
+			ID: "order-processing-abc123", RunID: "run-001-xyz", Type: "OrderWorkflow",
+			Status: temporal.StatusRunning, TaskQueue: "order-tasks",
+			StartTime: now.Add(-5 * time.Minute),
+			Input:     `{"orderId": "abc123", "items": 3}`,
+			Memo: map[string]string{
+				"source":   `"web-checkout"`,
+				"shipping": `{"carrier":"ups","expedited":true,"address":{"city":"Berlin","country":"DE"}}`,
+			},
+			SearchAttributes: map[string]temporal.SearchAttribute{
+				"CustomerId": {Type: temporal.SearchAttributeKeyword, Value: "cust-42"},
+				"OrderId":    {Type: temporal.SearchAttributeKeyword, Value: "abc123"},
+				"OrderTotal": {Type: temporal.SearchAttributeDouble, Value: 129.95},
+				"ItemCount":  {Type: temporal.SearchAttributeInt, Value: int64(3)},
+				"Expedited":  {Type: temporal.SearchAttributeBool, Value: true},
+				"PromisedBy": {Type: temporal.SearchAttributeDatetime, Value: now.Add(48 * time.Hour).Truncate(time.Second)},
+				"Tags":       {Type: temporal.SearchAttributeKeywordList, Value: []string{"vip", "eu"}},
+			},
+		},
+		{
+			ID: "payment-xyz789", RunID: "run-002-abc", Type: "PaymentWorkflow",
+			Status: temporal.StatusCompleted, TaskQueue: "payment-tasks",
+			StartTime: now.Add(-1 * time.Hour), EndTime: ptr(now.Add(-55 * time.Minute)),
+			Input: `{"amount": 42.5}`, Output: `{"transactionId": "txn-123"}`,
+		},
+		{
+			ID: "shipment-def456", RunID: "run-003-def", Type: "ShipmentWorkflow",
+			Status: temporal.StatusFailed, TaskQueue: "shipment-tasks",
+			StartTime: now.Add(-30 * time.Minute), EndTime: ptr(now.Add(-25 * time.Minute)),
+		},
+		{
+			ID: "inventory-check-111", RunID: "run-004-ghi", Type: "InventoryWorkflow",
+			Status: temporal.StatusRunning, TaskQueue: "inventory-tasks",
+			StartTime: now.Add(-10 * time.Minute),
+			// Stuck retrying an activity against an unreachable database
+			PendingActivities: []temporal.PendingActivity{{
+				ActivityID: "5", ActivityType: "ReserveStock", State: "Scheduled",
+				Attempt: 7, ScheduledTime: ptr(now.Add(-9 * time.Minute)),
+				LastStartedTime: ptr(now.Add(-70 * time.Second)), LastHeartbeatTime: ptr(now.Add(-65 * time.Second)),
+				HeartbeatDetails:   `{"reserved":2,"total":3}`,
+				LastFailure:        "dial tcp inventory-db:5432: connect: connection refused",
+				LastWorkerIdentity: "worker-2@host", CurrentRetryInterval: 64 * time.Second,
+				LastAttemptCompleteTime: ptr(now.Add(-50 * time.Second)), NextAttemptScheduleTime: ptr(now.Add(14 * time.Second)),
+				Options: &temporal.ActivityOptions{
+					StartToCloseTimeout: 30 * time.Second, HeartbeatTimeout: 10 * time.Second,
+					InitialInterval: time.Second, BackoffCoefficient: 2, MaximumInterval: 100 * time.Second,
+				},
+			}},
+		},
+		{
+			ID: "user-signup-222", RunID: "run-005-jkl", Type: "UserOnboardingWorkflow",
+			Status: temporal.StatusCompleted, TaskQueue: "user-tasks",
+			StartTime: now.Add(-2 * time.Hour), EndTime: ptr(now.Add(-1*time.Hour - 45*time.Minute)),
+		},
+		{
+			ID: "refund-process-333", RunID: "run-006-mno", Type: "RefundWorkflow",
+			Status: temporal.StatusCanceled, TaskQueue: "payment-tasks",
+			StartTime: now.Add(-45 * time.Minute), EndTime: ptr(now.Add(-40 * time.Minute)),
+		},
+		{
+			ID: "email-campaign-444", RunID: "run-007-pqr", Type: "EmailCampaignWorkflow",
+			Status: temporal.StatusRunning, TaskQueue: "email-tasks",
+			StartTime: now.Add(-15 * time.Minute),
+			PendingChildren: []temporal.PendingChild{{
+				WorkflowID: "email-campaign-444-batch-1", RunID: "run-017-gh4", WorkflowType: "EmailBatchWorkflow",
+				InitiatedEventID: 5, ParentClosePolicy: "Terminate",
+			}},
+			PendingNexusOperations: []temporal.PendingNexusOperation{{
+				Endpoint: "notifications", Service: "EmailService", Operation: "SendDigest",
+				State: "BackingOff", Attempt: 3, ScheduledEventID: 6,
+				ScheduledTime: ptr(now.Add(-14 * time.Minute)), ScheduleToCloseTimeout: time.Hour,
+				NextAttemptScheduleTime: ptr(now.Add(30 * time.Second)),
+				LastAttemptFailure:      "handler error (UNAVAILABLE): upstream mail relay timed out",
+			}},
+		},
+		{
+			ID: "data-sync-555", RunID: "run-008-stu", Type: "DataSyncWorkflow",
+			Status: temporal.StatusTerminated, TaskQueue: "sync-tasks",
+			StartTime: now.Add(-3 * time.Hour), EndTime: ptr(now.Add(-2 * time.Hour)),
+		},
+		{
+			ID: "report-gen-666", RunID: "run-009-vwx", Type: "ReportWorkflow",
+			Status: temporal.StatusTimedOut, TaskQueue: "report-tasks",
+			StartTime: now.Add(-4 * time.Hour), EndTime: ptr(now.Add(-3*time.Hour - 30*time.Minute)),
+		},
+		{
+			ID: "cleanup-job-777", RunID: "run-010-yz0", Type: "CleanupWorkflow",
+			Status: temporal.StatusRunning, TaskQueue: "maintenance-tasks",
+			StartTime: now.Add(-2 * time.Minute),
+			// No worker is polling maintenance-tasks
+			PendingWorkflowTask: &temporal.PendingWorkflowTask{
+				State: "Scheduled", Attempt: 1, ScheduledTime: ptr(now.Add(-2 * time.Minute)),
+			},
+		},
+		{
+			ID: "notification-888", RunID: "run-011-123", Type: "NotificationWorkflow",
+			Status: temporal.StatusCompleted, TaskQueue: "notification-tasks",
+			StartTime: now.Add(-20 * time.Minute), EndTime: ptr(now.Add(-19 * time.Minute)),
+		},
+		{
+			ID: "batch-import-999", RunID: "run-012-456", Type: "BatchImportWorkflow",
+			Status: temporal.StatusFailed, TaskQueue: "import-tasks",
+			StartTime: now.Add(-1*time.Hour - 30*time.Minute), EndTime: ptr(now.Add(-1 * time.Hour)),
+		},
+		{
+			ID: "child-workflow-aaa", RunID: "run-013-789", Type: "ChildWorkflow",
+			Status: temporal.StatusCompleted, TaskQueue: "order-tasks",
+			StartTime: now.Add(-4 * time.Minute), EndTime: ptr(now.Add(-3 * time.Minute)),
+			ParentID: ptr("order-processing-abc123"), ParentRunID: "run-001-xyz",
+		},
+		// A long-lived entity that continues as new every day
+		{
+			ID: "customer-entity-42", RunID: "run-014-ab1", Type: "CustomerEntityWorkflow",
+			Status: temporal.StatusContinuedAsNew, TaskQueue: "entity-tasks",
+			StartTime: now.Add(-50 * time.Hour), EndTime: ptr(now.Add(-26 * time.Hour)),
+			FirstRunID: "run-014-ab1", NextRunID: "run-015-cd2",
+		},
+		{
+			ID: "customer-entity-42", RunID: "run-015-cd2", Type: "CustomerEntityWorkflow",
+			Status: temporal.StatusContinuedAsNew, TaskQueue: "entity-tasks",
+			StartTime: now.Add(-26 * time.Hour), EndTime: ptr(now.Add(-2 * time.Hour)),
+			FirstRunID: "run-014-ab1", PrevRunID: "run-014-ab1", NextRunID: "run-016-ef3",
+		},
+		{
+			ID: "customer-entity-42", RunID: "run-016-ef3", Type: "CustomerEntityWorkflow",
+			Status: temporal.StatusRunning, TaskQueue: "entity-tasks",
+			StartTime:  now.Add(-2 * time.Hour),
+			FirstRunID: "run-014-ab1", PrevRunID: "run-015-cd2",
+		},
+	}
+
+	fixtures := make([]WorkflowFixture, len(workflows))
+	for i, w := range workflows {
+		fixtures[i] = WorkflowFixture{Workflow: w}
+	}
+
+	// The order workflow gets a hand-written history exercising activities,
+	// retries, timers and child workflows.
+	fixtures[0].History = orderHistory(now)
+	fixtures[0].Queries = map[string]json.RawMessage{
+		"getStatus": json.RawMessage(`{"stage": "awaiting-shipment", "paid": true}`),
+	}
+	return fixtures
+}
+
+func orderHistory(now time.Time) []temporal.EnhancedHistoryEvent {
+	at := func(d time.Duration) time.Time { return now.Add(-d) }
+	return []temporal.EnhancedHistoryEvent{
+		{ID: 1, Type: "WorkflowExecutionStarted", Time: at(5 * time.Minute), Details: `WorkflowType: OrderWorkflow, TaskQueue: order-tasks, Input: {"orderId": "abc123", "items": 3}`, TaskQueue: "order-tasks"},
+		{ID: 2, Type: "WorkflowTaskScheduled", Time: at(5 * time.Minute), Details: "TaskQueue: order-tasks", TaskQueue: "order-tasks"},
+		{ID: 3, Type: "WorkflowTaskStarted", Time: at(5 * time.Minute), Details: "Identity: worker-1@host", ScheduledEventID: 2, Identity: "worker-1@host"},
+		{ID: 4, Type: "WorkflowTaskCompleted", Time: at(5 * time.Minute), Details: "ScheduledEventId: 2", ScheduledEventID: 2, StartedEventID: 3},
+		{ID: 5, Type: "ActivityTaskScheduled", Time: at(4*time.Minute + 50*time.Second), Details: "ActivityType: ValidateOrder, TaskQueue: order-tasks", ActivityType: "ValidateOrder", ActivityID: "1", TaskQueue: "order-tasks"},
+		{ID: 6, Type: "ActivityTaskStarted", Time: at(4*time.Minute + 45*time.Second), Details: "Identity: worker-1@host, Attempt: 1", ScheduledEventID: 5, Attempt: 1, Identity: "worker-1@host"},
+		{ID: 7, Type: "ActivityTaskCompleted", Time: at(4*time.Minute + 40*time.Second), Details: `ScheduledEventId: 5, Result: {"valid": true}`, ScheduledEventID: 5, StartedEventID: 6, Result: `{"valid": true}`},
+		{ID: 8, Type: "WorkflowTaskScheduled", Time: at(4*time.Minute + 40*time.Second), Details: "TaskQueue: order-tasks", TaskQueue: "order-tasks"},
+		{ID: 9, Type: "WorkflowTaskStarted", Time: at(4*time.Minute + 35*time.Second), Details: "Identity: worker-1@host", ScheduledEventID: 8, Identity: "worker-1@host"},
+		{ID: 10, Type: "WorkflowTaskCompleted", Time: at(4*time.Minute + 35*time.Second), Details: "ScheduledEventId: 8", ScheduledEventID: 8, StartedEventID: 9},
+		{ID: 11, Type: "ActivityTaskScheduled", Time: at(4*time.Minute + 30*time.Second), Details: "ActivityType: ProcessPayment, TaskQueue: payment-tasks", ActivityType: "ProcessPayment", ActivityID: "2", TaskQueue: "payment-tasks"},
+		{ID: 12, Type: "ActivityTaskStarted", Time: at(3*time.Minute + 40*time.Second), Details: "Identity: worker-3@host, Attempt: 2", ScheduledEventID: 11, Attempt: 2, Identity: "worker-3@host"},
+		{ID: 13, Type: "ActivityTaskCompleted", Time: at(3*time.Minute + 20*time.Second), Details: `ScheduledEventId: 11, Result: {"transactionId": "txn-123"}`, ScheduledEventID: 11, StartedEventID: 12, Result: `{"transactionId": "txn-123"}`},
+		{ID: 14, Type: "WorkflowTaskScheduled", Time: at(3*time.Minute + 20*time.Second), Details: "TaskQueue: order-tasks", TaskQueue: "order-tasks"},
+		{ID: 15, Type: "WorkflowTaskStarted", Time: at(3*time.Minute + 15*time.Second), Details: "Identity: worker-1@host", ScheduledEventID: 14, Identity: "worker-1@host"},
+		{ID: 16, Type: "WorkflowTaskCompleted", Time: at(3*time.Minute + 15*time.Second), Details: "ScheduledEventId: 14", ScheduledEventID: 14, StartedEventID: 15},
+		{ID: 17, Type: "StartChildWorkflowExecutionInitiated", Time: at(3*time.Minute + 10*time.Second), Details: "WorkflowType: ChildWorkflow, WorkflowId: child-workflow-aaa", ChildWorkflowID: "child-workflow-aaa", ChildWorkflowType: "ChildWorkflow"},
+		{ID: 18, Type: "ChildWorkflowExecutionStarted", Time: at(3*time.Minute + 5*time.Second), Details: "WorkflowId: child-workflow-aaa, RunId: run-013-789", InitiatedEventID: 17, ChildWorkflowID: "child-workflow-aaa", ChildWorkflowType: "ChildWorkflow", ChildRunID: "run-013-789"},
+		{ID: 19, Type: "ChildWorkflowExecutionCompleted", Time: at(3 * time.Minute), Details: "WorkflowId: child-workflow-aaa", InitiatedEventID: 17, StartedEventID: 18, ChildWorkflowID: "child-workflow-aaa", ChildWorkflowType: "ChildWorkflow", ChildRunID: "run-013-789"},
+		{ID: 20, Type: "WorkflowTaskScheduled", Time: at(3 * time.Minute), Details: "TaskQueue: order-tasks", TaskQueue: "order-tasks"},
+		{ID: 21, Type: "WorkflowTaskStarted", Time: at(3 * time.Minute), Details: "Identity: worker-1@host", ScheduledEventID: 20, Identity: "worker-1@host"},
+		{ID: 22, Type: "WorkflowTaskCompleted", Time: at(3 * time.Minute), Details: "ScheduledEventId: 20", ScheduledEventID: 20, StartedEventID: 21},
+		{ID: 23, Type: "TimerStarted", Time: at(3 * time.Minute), Details: "TimerId: wait-for-shipment, StartToFireTimeout: 24h", TimerID: "wait-for-shipment"},
+	}
+}
+
+func defaultSchedules(now time.Time) []temporal.Schedule {
+	nextRun := now.Add(5 * time.Minute)
+	lastRun := now.Add(-1 * time.Hour)
+	return []temporal.Schedule{
+		{
+			ID:            "daily-report",
+			WorkflowType:  "ReportWorkflow",
+			WorkflowID:    "daily-report-wf",
+			TaskQueue:     "report-tasks",
+			Spec:          "0 9 * * *",
+			NextRunTime:   &nextRun,
+			LastRunTime:   &lastRun,
+			LastRunStatus: temporal.StatusCompleted,
+			TotalActions:  365,
+			OverlapPolicy: "Skip",
+			Notes:         "Daily report generation",
+			Definition: &temporal.ScheduleDefinition{
+				ID:     "daily-report",
+				Spec:   temporal.ScheduleSpec{CronExpressions: []string{"0 9 * * *"}},
+				Action: temporal.ScheduleAction{WorkflowID: "daily-report-wf", WorkflowType: "ReportWorkflow", TaskQueue: "report-tasks"},
+				Policy: temporal.SchedulePolicy{Overlap: "Skip"},
+				Notes:  "Daily report generation",
+			},
+		},
+		{
+			ID:            "hourly-cleanup",
+			WorkflowType:  "CleanupWorkflow",
+			WorkflowID:    "hourly-cleanup-wf",
+			TaskQueue:     "maintenance-tasks",
+			Spec:          "every 1h",
+			NextRunTime:   &nextRun,
+			LastRunTime:   &lastRun,
+			LastRunStatus: temporal.StatusCompleted,
+			TotalActions:  2190,
+			OverlapPolicy: "Skip",
+			Notes:         "Hourly cleanup tasks",
+			Definition: &temporal.ScheduleDefinition{
+				ID:     "hourly-cleanup",
+				Spec:   temporal.ScheduleSpec{Intervals: []temporal.ScheduleInterval{{Every: time.Hour}}},
+				Action: temporal.ScheduleAction{WorkflowID: "hourly-cleanup-wf", WorkflowType: "CleanupWorkflow", TaskQueue: "maintenance-tasks"},
+				Policy: temporal.SchedulePolicy{Overlap: "Skip"},
+				Notes:  "Hourly cleanup tasks",
+			},
+		},
+		{
+			ID:            "weekly-backup",
+			WorkflowType:  "BackupWorkflow",
+			WorkflowID:    "weekly-backup-wf",
+			TaskQueue:     "sync-tasks",
+			Spec:          "0 0 * * 0",
+			Paused:        true,
+			LastRunTime:   &lastRun,
+			LastRunStatus: temporal.StatusFailed,
+			TotalActions:  52,
+			OverlapPolicy: "BufferOne",
+			Notes:         "Weekly backups (paused)",
+			Definition: &temporal.ScheduleDefinition{
+				ID:   "weekly-backup",
+				Spec: temporal.ScheduleSpec{CronExpressions: []string{"0 0 * * 0"}},
+				Action: temporal.ScheduleAction{
+					WorkflowID:   "weekly-backup-wf",
+					WorkflowType: "BackupWorkflow",
+					TaskQueue:    "sync-tasks",
+					Input:        []byte(`{"target":"s3://backups"}`),
+				},
+				Policy: temporal.SchedulePolicy{Overlap: "BufferOne", PauseOnFailure: true},
+				Paused: true,
+				Notes:  "Weekly backups (paused)",
+			},
+		},
+	}
+}
+
+func defaultTaskQueues(now time.Time) []TaskQueueFixture {
+	pollers := func(workers ...string) []temporal.Poller {
+		var ps []temporal.Poller
+		for i, w := range workers {
+			ps = append(ps,
+				temporal.Poller{Identity: w, LastAccessTime: now.Add(-time.Duration(i+1) * time.Second), TaskQueueType: temporal.TaskQueueTypeWorkflow, RatePerSecond: 100000},
+				temporal.Poller{Identity: w, LastAccessTime: now.Add(-time.Duration(i+2) * time.Second), TaskQueueType: temporal.TaskQueueTypeActivity, RatePerSecond: 100000},
+			)
+		}
+		return ps
+	}
+	return []TaskQueueFixture{
+		{Name: "order-tasks", Backlog: 12, Pollers: pollers("worker-1@host-001", "worker-2@host-002")},
+		{Name: "payment-tasks", Backlog: 0, Pollers: pollers("worker-3@host-003")},
+		{Name: "shipment-tasks", Backlog: 5, Pollers: pollers("worker-2@host-002")},
+		{Name: "inventory-tasks", Backlog: 0, Pollers: pollers("worker-2@host-002")},
+		{Name: "notification-tasks", Backlog: 100, Pollers: pollers("worker-4@host-004")},
+	}
+}
+
+func defaultBatchOperations(now time.Time) []temporal.BatchOperation {
+	closed := now.Add(-50 * time.Minute)
+	return []temporal.BatchOperation{
+		{
+			JobID:          "terminate-stuck-orders",
+			Type:           string(temporal.BatchTerminate),
+			State:          temporal.BatchStateRunning,
+			StartTime:      now.Add(-2 * time.Minute),
+			TotalCount:     24000,
+			CompletedCount: 9120,
+			FailureCount:   14,
+			Reason:         "Incident: orders stuck on payment gateway",
+			Identity:       "oncall@ops-laptop",
+		},
+		{
+			JobID:          "signal-refresh-config",
+			Type:           string(temporal.BatchSignal),
+			State:          temporal.BatchStateCompleted,
+			StartTime:      now.Add(-time.Hour),
+			CloseTime:      &closed,
+			TotalCount:     312,
+			CompletedCount: 312,
+			Reason:         "Roll out new rate limits",
+			Identity:       "deploy-bot",
+		},
+	}
+}
+
+// HistoryFixture seeds a single namespace with one workflow and its recorded
+// history, so an exported history file can be browsed without a server.
+func HistoryFixture(namespace string, hf *temporal.HistoryFile) *Fixture {
+	return &Fixture{
+		Namespaces: []NamespaceFixture{{
+			NamespaceDetail: temporal.NamespaceDetail{
+				Namespace: temporal.Namespace{
+					Name:        namespace,
+					Description: "Workflow history opened from a file",
+				},
+			},
+			Workflows: []WorkflowFixture{{
+				Workflow: hf.Workflow,
+				History:  hf.Events,
+			}},
+		}},
+	}
+}
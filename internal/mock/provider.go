@@ -0,0 +1,1728 @@
+// Package mock provides an in-memory temporal.Provider for offline demos and testing.
+package mock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/temporalproto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Provider is a stateful, in-memory implementation of temporal.Provider.
+// Mutations (cancel, terminate, signal, reset, activity, schedule and namespace changes)
+// update its state so every screen can be exercised without a server.
+type Provider struct {
+	mu         sync.RWMutex
+	config     temporal.ConnectionConfig
+	connected  bool
+	namespaces []*namespaceState
+}
+
+type namespaceState struct {
+	detail           temporal.NamespaceDetail
+	workflows        []*workflowState
+	schedules        []*temporal.Schedule
+	taskQueues       map[string]*TaskQueueFixture
+	batchJobs        []*temporal.BatchOperation
+	searchAttributes map[string]string // Custom search attribute types by name
+}
+
+type workflowState struct {
+	workflow temporal.Workflow
+	history  []temporal.EnhancedHistoryEvent
+	queries  map[string]json.RawMessage
+}
+
+// Ensure Provider implements temporal.Provider.
+var _ temporal.Provider = (*Provider)(nil)
+
+// NewProvider creates a mock provider seeded from a fixture.
+// A nil fixture seeds the provider with DefaultFixture.
+func NewProvider(fixture *Fixture) *Provider {
+	if fixture == nil {
+		fixture = DefaultFixture()
+	}
+
+	p := &Provider{
+		config:    temporal.ConnectionConfig{Address: "mock", Namespace: "default"},
+		connected: true,
+	}
+	now := time.Now()
+
+	for _, nf := range fixture.Namespaces {
+		ns := &namespaceState{
+			detail:           nf.NamespaceDetail,
+			taskQueues:       make(map[string]*TaskQueueFixture),
+			searchAttributes: make(map[string]string),
+		}
+		for name, typ := range nf.SearchAttributes {
+			ns.searchAttributes[name] = typ
+		}
+		if ns.detail.State == "" {
+			ns.detail.State = temporal.NamespaceStateActive
+		}
+
+		for _, wf := range nf.Workflows {
+			ws := &workflowState{
+				workflow: wf.Workflow,
+				history:  wf.History,
+				queries:  wf.Queries,
+			}
+			ws.workflow.Namespace = ns.detail.Name
+			if ws.workflow.RunID == "" {
+				ws.workflow.RunID = newRunID()
+			}
+			if ws.workflow.Status == "" {
+				ws.workflow.Status = temporal.StatusRunning
+			}
+			if ws.workflow.StartTime.IsZero() {
+				ws.workflow.StartTime = now
+			}
+			if len(ws.history) == 0 {
+				ws.history = synthesizeHistory(ws.workflow)
+			}
+			ns.registerSearchAttributes(ws.workflow.SearchAttributes)
+			ns.workflows = append(ns.workflows, ws)
+		}
+
+		for i := range nf.Schedules {
+			s := nf.Schedules[i]
+			ns.schedules = append(ns.schedules, &s)
+		}
+
+		for i := range nf.TaskQueues {
+			tq := nf.TaskQueues[i]
+			ns.taskQueues[tq.Name] = &tq
+		}
+
+		for i := range nf.BatchOperations {
+			job := nf.BatchOperations[i]
+			ns.batchJobs = append(ns.batchJobs, &job)
+		}
+
+		p.namespaces = append(p.namespaces, ns)
+	}
+
+	if len(p.namespaces) > 0 {
+		p.config.Namespace = p.namespaces[0].detail.Name
+	}
+	return p
+}
+
+// newRunID returns a random UUID-formatted run ID.
+func newRunID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// synthesizeHistory builds a minimal history consistent with a workflow's status.
+func synthesizeHistory(w temporal.Workflow) []temporal.EnhancedHistoryEvent {
+	ws := &workflowState{workflow: w}
+	start := w.StartTime
+	details := fmt.Sprintf("WorkflowType: %s, TaskQueue: %s", w.Type, w.TaskQueue)
+	if w.Input != "" {
+		details += ", Input: " + w.Input
+	}
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionStarted", Time: start, Details: details, TaskQueue: w.TaskQueue})
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskScheduled", Time: start, Details: "TaskQueue: " + w.TaskQueue, TaskQueue: w.TaskQueue})
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskStarted", Time: start, Details: "Identity: mock-worker", ScheduledEventID: 2, Identity: "mock-worker"})
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskCompleted", Time: start, Details: "ScheduledEventId: 2", ScheduledEventID: 2, StartedEventID: 3})
+
+	end := start
+	if w.EndTime != nil {
+		end = *w.EndTime
+	}
+	switch w.Status {
+	case temporal.StatusCompleted:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionCompleted", Time: end, Details: "Result: " + w.Output, Result: w.Output})
+	case temporal.StatusFailed:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionFailed", Time: end, Details: "Failure: workflow failed", Failure: "workflow failed"})
+	case temporal.StatusCanceled:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionCancelRequested", Time: end, Details: "Cause: cancel requested"})
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionCanceled", Time: end})
+	case temporal.StatusTerminated:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTerminated", Time: end, Details: "Reason: terminated"})
+	case temporal.StatusTimedOut:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTimedOut", Time: end, Details: "RetryState: Timeout"})
+	case temporal.StatusContinuedAsNew:
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionContinuedAsNew", Time: end, Details: "NewExecutionRunId: " + w.NextRunID + ", TaskQueue: " + w.TaskQueue, TaskQueue: w.TaskQueue})
+	}
+	return ws.history
+}
+
+// append adds an event to the history, assigning the next event ID.
+func (ws *workflowState) append(ev temporal.EnhancedHistoryEvent) {
+	ev.ID = int64(len(ws.history) + 1)
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	ws.history = append(ws.history, ev)
+}
+
+// close transitions a running workflow to a terminal status.
+func (ws *workflowState) close(status string) {
+	now := time.Now()
+	ws.workflow.Status = status
+	ws.workflow.EndTime = &now
+	ws.workflow.PendingActivities = nil
+	ws.workflow.PendingChildren = nil
+	ws.workflow.PendingNexusOperations = nil
+	ws.workflow.PendingWorkflowTask = nil
+}
+
+func (p *Provider) namespace(name string) (*namespaceState, error) {
+	for _, ns := range p.namespaces {
+		if ns.detail.Name == name {
+			return ns, nil
+		}
+	}
+	return nil, fmt.Errorf("namespace %s not found", name)
+}
+
+// findWorkflow returns the run matching workflowID and runID.
+// An empty runID resolves to the most recently started (current) run.
+func (p *Provider) findWorkflow(namespace, workflowID, runID string) (*namespaceState, *workflowState, error) {
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var found *workflowState
+	for _, ws := range ns.workflows {
+		if ws.workflow.ID != workflowID {
+			continue
+		}
+		if runID != "" {
+			if ws.workflow.RunID == runID {
+				return ns, ws, nil
+			}
+			continue
+		}
+		if found == nil || !ws.workflow.StartTime.Before(found.workflow.StartTime) {
+			found = ws
+		}
+	}
+	if found == nil {
+		return nil, nil, fmt.Errorf("workflow execution not found: %s", workflowID)
+	}
+	return ns, found, nil
+}
+
+// findRunning returns the run matching workflowID and runID, which must be running.
+func (p *Provider) findRunning(namespace, workflowID, runID string) (*workflowState, error) {
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	if ws.workflow.Status != temporal.StatusRunning {
+		return nil, fmt.Errorf("workflow execution already completed: %s", workflowID)
+	}
+	return ws, nil
+}
+
+func (p *Provider) findSchedule(namespace, scheduleID string) (*namespaceState, int, error) {
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i, s := range ns.schedules {
+		if s.ID == scheduleID {
+			return ns, i, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("schedule not found: %s", scheduleID)
+}
+
+// paginate slices items according to ListOptions, using the offset as the page token.
+func paginate[T any](items []T, opts temporal.ListOptions) ([]T, string, error) {
+	offset := 0
+	if opts.PageToken != "" {
+		n, err := strconv.Atoi(opts.PageToken)
+		if err != nil || n < 0 {
+			return nil, "", fmt.Errorf("invalid page token: %q", opts.PageToken)
+		}
+		offset = n
+	}
+	if offset > len(items) {
+		offset = len(items)
+	}
+
+	end := len(items)
+	if opts.PageSize > 0 && offset+opts.PageSize < end {
+		end = offset + opts.PageSize
+	}
+
+	nextToken := ""
+	if end < len(items) {
+		nextToken = strconv.Itoa(end)
+	}
+	return items[offset:end], nextToken, nil
+}
+
+// Connection Management
+
+// Close marks the provider as disconnected.
+func (p *Provider) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.connected = false
+	return nil
+}
+
+// IsConnected returns whether the provider is connected.
+func (p *Provider) IsConnected() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.connected
+}
+
+// CheckConnection returns an error if the provider has been closed.
+func (p *Provider) CheckConnection(ctx context.Context) error {
+	if !p.IsConnected() {
+		return fmt.Errorf("client not connected")
+	}
+	return nil
+}
+
+// Reconnect marks the provider as connected again.
+func (p *Provider) Reconnect(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.connected = true
+	return nil
+}
+
+// ReconnectWithConfig records the new configuration; the data set is unchanged.
+func (p *Provider) ReconnectWithConfig(ctx context.Context, config temporal.ConnectionConfig) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+	p.connected = true
+	return nil
+}
+
+// Config returns the connection configuration.
+func (p *Provider) Config() temporal.ConnectionConfig {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.config
+}
+
+// Namespaces
+
+// ListNamespaces returns all namespaces.
+func (p *Provider) ListNamespaces(ctx context.Context) ([]temporal.Namespace, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	namespaces := make([]temporal.Namespace, 0, len(p.namespaces))
+	for _, ns := range p.namespaces {
+		namespaces = append(namespaces, ns.detail.Namespace)
+	}
+	return namespaces, nil
+}
+
+// CreateNamespace registers a new, empty namespace.
+func (p *Provider) CreateNamespace(ctx context.Context, req temporal.NamespaceCreateRequest) error {
+	if req.RetentionDays < 1 {
+		return fmt.Errorf("retention period must be at least 1 day")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.namespace(req.Name); err == nil {
+		return fmt.Errorf("failed to create namespace: namespace %s already exists", req.Name)
+	}
+
+	now := time.Now()
+	p.namespaces = append(p.namespaces, &namespaceState{
+		detail: temporal.NamespaceDetail{
+			Namespace: temporal.Namespace{
+				Name:            req.Name,
+				State:           temporal.NamespaceStateActive,
+				RetentionPeriod: formatRetention(req.RetentionDays),
+				Description:     req.Description,
+				OwnerEmail:      req.OwnerEmail,
+			},
+			ID:                 newRunID(),
+			CreatedAt:          now,
+			UpdatedAt:          now,
+			HistoryArchival:    "Disabled",
+			VisibilityArchival: "Disabled",
+			Clusters:           []string{"active"},
+		},
+		taskQueues:       make(map[string]*TaskQueueFixture),
+		searchAttributes: make(map[string]string),
+	})
+	return nil
+}
+
+func formatRetention(days int) string {
+	if days == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
+
+// DescribeNamespace returns detailed information about a namespace.
+func (p *Provider) DescribeNamespace(ctx context.Context, name string) (*temporal.NamespaceDetail, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe namespace: %w", err)
+	}
+	detail := ns.detail
+	detail.Clusters = append([]string(nil), ns.detail.Clusters...)
+	return &detail, nil
+}
+
+// UpdateNamespace modifies a namespace, preserving fields left empty.
+func (p *Provider) UpdateNamespace(ctx context.Context, req temporal.NamespaceUpdateRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(req.Name)
+	if err != nil {
+		return fmt.Errorf("failed to update namespace: %w", err)
+	}
+	if req.Description != "" {
+		ns.detail.Description = req.Description
+	}
+	if req.OwnerEmail != "" {
+		ns.detail.OwnerEmail = req.OwnerEmail
+	}
+	if req.RetentionDays > 0 {
+		ns.detail.RetentionPeriod = formatRetention(req.RetentionDays)
+	}
+	ns.detail.UpdatedAt = time.Now()
+	return nil
+}
+
+// DeprecateNamespace marks a namespace as deprecated.
+func (p *Provider) DeprecateNamespace(ctx context.Context, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(name)
+	if err != nil {
+		return fmt.Errorf("failed to deprecate namespace: %w", err)
+	}
+	ns.detail.State = temporal.NamespaceStateDeprecated
+	ns.detail.UpdatedAt = time.Now()
+	return nil
+}
+
+// DeleteNamespace removes a namespace and everything in it.
+func (p *Provider) DeleteNamespace(ctx context.Context, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, ns := range p.namespaces {
+		if ns.detail.Name == name {
+			p.namespaces = append(p.namespaces[:i], p.namespaces[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("failed to delete namespace: namespace %s not found", name)
+}
+
+// systemSearchAttributes are the built-in attributes every namespace has.
+var systemSearchAttributes = map[string]string{
+	"BatcherNamespace":           temporal.SearchAttributeKeyword,
+	"BatcherUser":                temporal.SearchAttributeKeyword,
+	"BuildIds":                   temporal.SearchAttributeKeywordList,
+	"CloseTime":                  temporal.SearchAttributeDatetime,
+	"ExecutionDuration":          temporal.SearchAttributeInt,
+	"ExecutionStatus":            temporal.SearchAttributeKeyword,
+	"ExecutionTime":              temporal.SearchAttributeDatetime,
+	"HistoryLength":              temporal.SearchAttributeInt,
+	"HistorySizeBytes":           temporal.SearchAttributeInt,
+	"RunId":                      temporal.SearchAttributeKeyword,
+	"StartTime":                  temporal.SearchAttributeDatetime,
+	"StateTransitionCount":       temporal.SearchAttributeInt,
+	"TaskQueue":                  temporal.SearchAttributeKeyword,
+	"TemporalChangeVersion":      temporal.SearchAttributeKeywordList,
+	"TemporalSchedulePaused":     temporal.SearchAttributeBool,
+	"TemporalScheduledById":      temporal.SearchAttributeKeyword,
+	"TemporalScheduledStartTime": temporal.SearchAttributeDatetime,
+	"WorkflowId":                 temporal.SearchAttributeKeyword,
+	"WorkflowType":               temporal.SearchAttributeKeyword,
+}
+
+// registerSearchAttributes adds the attributes a workflow sets that aren't registered yet.
+// The mock accepts any attribute on start rather than rejecting unknown names.
+func (ns *namespaceState) registerSearchAttributes(attrs map[string]temporal.SearchAttribute) {
+	for name, sa := range attrs {
+		if _, ok := systemSearchAttributes[name]; ok {
+			continue
+		}
+		if _, ok := ns.searchAttributes[name]; !ok && sa.Type != "" {
+			ns.searchAttributes[name] = sa.Type
+		}
+	}
+}
+
+// ListSearchAttributes returns the system attributes and the namespace's custom ones.
+func (p *Provider) ListSearchAttributes(ctx context.Context, namespace string) ([]temporal.SearchAttributeInfo, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search attributes: %w", err)
+	}
+	infos := make([]temporal.SearchAttributeInfo, 0, len(systemSearchAttributes)+len(ns.searchAttributes))
+	for name, typ := range systemSearchAttributes {
+		infos = append(infos, temporal.SearchAttributeInfo{Name: name, Type: typ, System: true})
+	}
+	for name, typ := range ns.searchAttributes {
+		infos = append(infos, temporal.SearchAttributeInfo{Name: name, Type: typ})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
+// AddSearchAttributes registers custom search attributes. Names already in use are rejected.
+func (p *Provider) AddSearchAttributes(ctx context.Context, namespace string, attributes map[string]string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to add search attributes: %w", err)
+	}
+	for name, typ := range attributes {
+		if !isCustomSearchAttribute(name) {
+			return fmt.Errorf("failed to add search attributes: invalid name %q", name)
+		}
+		if _, ok := systemSearchAttributes[name]; ok {
+			return fmt.Errorf("failed to add search attributes: %s is a system search attribute", name)
+		}
+		if _, ok := ns.searchAttributes[name]; ok {
+			return fmt.Errorf("failed to add search attributes: search attribute %s already exists", name)
+		}
+		if !slices.Contains(temporal.SearchAttributeTypes, typ) {
+			return fmt.Errorf("failed to add search attributes: unknown search attribute type %q", typ)
+		}
+	}
+	for name, typ := range attributes {
+		ns.searchAttributes[name] = typ
+	}
+	return nil
+}
+
+// RemoveSearchAttributes unregisters custom search attributes.
+// Values already set on workflows are kept, as they are by the server.
+func (p *Provider) RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to remove search attributes: %w", err)
+	}
+	for _, name := range names {
+		if _, ok := systemSearchAttributes[name]; ok {
+			return fmt.Errorf("failed to remove search attributes: %s is a system search attribute", name)
+		}
+		if _, ok := ns.searchAttributes[name]; !ok {
+			return fmt.Errorf("failed to remove search attributes: search attribute %s not found", name)
+		}
+	}
+	for _, name := range names {
+		delete(ns.searchAttributes, name)
+	}
+	return nil
+}
+
+// Workflows
+
+// ListWorkflows returns workflows matching the visibility query, newest first.
+func (p *Provider) ListWorkflows(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Workflow, string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	matches, err := matching(ns, opts.Query)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list workflows: %w", err)
+	}
+	workflows := make([]temporal.Workflow, len(matches))
+	for i, ws := range matches {
+		workflows[i] = ws.workflow
+	}
+	sort.SliceStable(workflows, func(i, j int) bool {
+		return workflows[i].StartTime.After(workflows[j].StartTime)
+	})
+
+	return paginate(workflows, opts)
+}
+
+// matching returns the workflows in ns that satisfy a visibility query.
+func matching(ns *namespaceState, query string) ([]*workflowState, error) {
+	var matches []*workflowState
+	for _, ws := range ns.workflows {
+		ok, err := matchQuery(query, &ws.workflow)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matches = append(matches, ws)
+		}
+	}
+	return matches, nil
+}
+
+// GetWorkflow returns details for a specific workflow execution.
+func (p *Provider) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*temporal.Workflow, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe workflow: %w", err)
+	}
+	wf := ws.workflow
+	wf.HistoryLength = int64(len(ws.history))
+	wf.HistorySizeBytes = int64(historySize(ws.history))
+	if wf.Config == nil {
+		wf.Config = &temporal.ExecutionConfig{TaskQueue: wf.TaskQueue, WorkflowTaskTimeout: 10 * time.Second}
+	}
+	return &wf, nil
+}
+
+// GetWorkflowHistory returns the event history for a workflow execution.
+func (p *Provider) GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.HistoryEvent, error) {
+	enhanced, err := p.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]temporal.HistoryEvent, len(enhanced))
+	for i, ev := range enhanced {
+		events[i] = temporal.HistoryEvent{
+			ID:      ev.ID,
+			Type:    ev.Type,
+			Time:    ev.Time,
+			Details: ev.Details,
+		}
+	}
+	return events, nil
+}
+
+// GetEnhancedWorkflowHistory returns the event history with relational data.
+func (p *Provider) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.EnhancedHistoryEvent, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+	return append([]temporal.EnhancedHistoryEvent(nil), ws.history...), nil
+}
+
+// historyPageSize is the number of events returned per history page.
+const historyPageSize = 100
+
+// GetWorkflowHistoryPage returns one page of history. Tokens encode the offset of the next page.
+func (p *Provider) GetWorkflowHistoryPage(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+
+	var offset int
+	if len(pageToken) > 0 {
+		offset, err = strconv.Atoi(string(pageToken))
+		if err != nil || offset < 0 || offset > len(ws.history) {
+			return nil, fmt.Errorf("failed to get workflow history: invalid page token")
+		}
+	}
+
+	end := min(offset+historyPageSize, len(ws.history))
+	page := &temporal.HistoryPage{
+		Events: append([]temporal.EnhancedHistoryEvent(nil), ws.history[offset:end]...),
+	}
+	page.SizeBytes = historySize(page.Events)
+	if end < len(ws.history) {
+		page.NextPageToken = []byte(strconv.Itoa(end))
+	}
+	return page, nil
+}
+
+// ExportWorkflowHistory returns the history as protojson. Mock events carry no
+// attributes, so only event IDs, times, and types are exported.
+func (p *Provider) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+
+	history := &historypb.History{}
+	for _, ev := range ws.history {
+		eventType, _ := enumspb.EventTypeFromString(ev.Type)
+		history.Events = append(history.Events, &historypb.HistoryEvent{
+			EventId:   ev.ID,
+			EventTime: timestamppb.New(ev.Time),
+			EventType: eventType,
+		})
+	}
+
+	data, err := temporalproto.CustomJSONMarshalOptions{Indent: "  "}.Marshal(history)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workflow history: %w", err)
+	}
+	return data, nil
+}
+
+// historySize approximates the encoded size of events by their JSON length.
+func historySize(events []temporal.EnhancedHistoryEvent) int {
+	data, err := json.Marshal(events)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// followPollInterval is how long FollowWorkflowHistory waits for new events
+// before returning an empty page, mirroring the server's long-poll timeout.
+const followPollInterval = 20 * time.Second
+
+// FollowWorkflowHistory returns events after the page token, waiting for new
+// ones while the workflow is running. Tokens encode the last returned event ID.
+func (p *Provider) FollowWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	var after int64
+	if len(pageToken) > 0 {
+		id, err := strconv.ParseInt(string(pageToken), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to follow workflow history: invalid page token")
+		}
+		after = id
+	}
+
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+	deadline := time.After(followPollInterval)
+
+	for {
+		page, err := p.historyAfter(namespace, workflowID, runID, after)
+		if err != nil || len(page.Events) > 0 || len(page.NextPageToken) == 0 {
+			return page, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return page, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// historyAfter returns the events after the given event ID and the token to resume from.
+func (p *Provider) historyAfter(namespace, workflowID, runID string, after int64) (*temporal.HistoryPage, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to follow workflow history: %w", err)
+	}
+
+	page := &temporal.HistoryPage{}
+	last := after
+	for _, ev := range ws.history {
+		if ev.ID > after {
+			page.Events = append(page.Events, ev)
+			last = ev.ID
+		}
+	}
+	page.SizeBytes = historySize(page.Events)
+	if ws.workflow.Status == temporal.StatusRunning || len(page.Events) > 0 {
+		page.NextPageToken = []byte(strconv.FormatInt(last, 10))
+	}
+	return page, nil
+}
+
+// DescribeTaskQueue returns task queue info and pollers.
+func (p *Provider) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*temporal.TaskQueueInfo, []temporal.Poller, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to describe workflow task queue: %w", err)
+	}
+
+	info := &temporal.TaskQueueInfo{Name: taskQueue, Type: "Combined"}
+	tq, ok := ns.taskQueues[taskQueue]
+	if !ok {
+		return info, nil, nil
+	}
+
+	pollers := append([]temporal.Poller(nil), tq.Pollers...)
+	info.PollerCount = len(pollers)
+	info.Backlog = tq.Backlog
+	return info, pollers, nil
+}
+
+// Workflow Mutations
+
+// CancelWorkflow records a cancel request and closes the workflow as canceled.
+func (p *Provider) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ws, err := p.findRunning(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionCancelRequested", Details: "Cause: " + reason, Identity: "tempo"})
+	ws.close(temporal.StatusCanceled)
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionCanceled", Time: *ws.workflow.EndTime})
+	return nil
+}
+
+// TerminateWorkflow closes the workflow as terminated.
+func (p *Provider) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ws, err := p.findRunning(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	ws.close(temporal.StatusTerminated)
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTerminated", Time: *ws.workflow.EndTime, Details: "Reason: " + reason, Identity: "tempo"})
+	return nil
+}
+
+// SignalWorkflow appends a signal event to a running workflow.
+func (p *Provider) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ws, err := p.findRunning(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	ws.appendSignal(signalName, input)
+	return nil
+}
+
+func (ws *workflowState) appendSignal(signalName string, input []byte) {
+	details := "SignalName: " + signalName
+	if len(input) > 0 {
+		details += ", Input: " + string(input)
+	}
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionSignaled", Details: details, Identity: "tempo"})
+}
+
+// StartWorkflow starts a new workflow, applying the ID reuse and conflict policies.
+func (p *Provider) StartWorkflow(ctx context.Context, namespace string, req temporal.StartWorkflowRequest) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.startWorkflow(namespace, req)
+}
+
+// startWorkflow implements StartWorkflow; the caller must hold p.mu.
+func (p *Provider) startWorkflow(namespace string, req temporal.StartWorkflowRequest) (string, error) {
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to start workflow: %w", err)
+	}
+	if req.WorkflowType == "" || req.TaskQueue == "" {
+		return "", fmt.Errorf("failed to start workflow: workflow type and task queue are required")
+	}
+	if req.WorkflowID == "" {
+		req.WorkflowID = newRunID()
+	}
+
+	if _, latest, err := p.findWorkflow(namespace, req.WorkflowID, ""); err == nil {
+		if latest.workflow.Status == temporal.StatusRunning {
+			switch req.IDConflictPolicy {
+			case temporal.IDConflictUseExisting:
+				return latest.workflow.RunID, nil
+			case temporal.IDConflictTerminateExisting:
+				latest.close(temporal.StatusTerminated)
+				latest.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTerminated", Time: *latest.workflow.EndTime, Details: "Reason: terminated by start with TerminateExisting", Identity: "tempo"})
+			default:
+				if req.IDReusePolicy != temporal.IDReuseTerminateIfRunning {
+					return "", fmt.Errorf("failed to start workflow: workflow execution already started: %s", req.WorkflowID)
+				}
+				latest.close(temporal.StatusTerminated)
+				latest.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTerminated", Time: *latest.workflow.EndTime, Details: "Reason: terminated by start with TerminateIfRunning", Identity: "tempo"})
+			}
+		} else {
+			switch req.IDReusePolicy {
+			case temporal.IDReuseRejectDuplicate:
+				return "", fmt.Errorf("failed to start workflow: workflow execution already finished and reuse policy rejects duplicates: %s", req.WorkflowID)
+			case temporal.IDReuseAllowDuplicateFailedOnly:
+				if latest.workflow.Status == temporal.StatusCompleted {
+					return "", fmt.Errorf("failed to start workflow: workflow execution already completed successfully: %s", req.WorkflowID)
+				}
+			}
+		}
+	}
+
+	ws := &workflowState{
+		workflow: temporal.Workflow{
+			ID:        req.WorkflowID,
+			RunID:     newRunID(),
+			Type:      req.WorkflowType,
+			Status:    temporal.StatusRunning,
+			Namespace: namespace,
+			TaskQueue: req.TaskQueue,
+			StartTime: time.Now(),
+			Input:     string(req.Input),
+		},
+	}
+	if len(req.Memo) > 0 {
+		ws.workflow.Memo = make(map[string]string, len(req.Memo))
+		for k, v := range req.Memo {
+			data, _ := json.Marshal(v)
+			ws.workflow.Memo[k] = string(data)
+		}
+	}
+	if len(req.SearchAttributes) > 0 {
+		ws.workflow.SearchAttributes = make(map[string]temporal.SearchAttribute, len(req.SearchAttributes))
+		for k, v := range req.SearchAttributes {
+			ws.workflow.SearchAttributes[k] = searchAttributeFromJSON(v)
+		}
+		ns.registerSearchAttributes(ws.workflow.SearchAttributes)
+	}
+	// Started and the first workflow task; no mock worker picks it up.
+	ws.history = synthesizeHistory(ws.workflow)[:2]
+	ns.workflows = append(ns.workflows, ws)
+	return ws.workflow.RunID, nil
+}
+
+// searchAttributeFromJSON infers the type of a search attribute given as decoded JSON,
+// the way the server would for an attribute it hasn't seen registered.
+func searchAttributeFromJSON(v any) temporal.SearchAttribute {
+	switch val := v.(type) {
+	case string:
+		if t, err := time.Parse(time.RFC3339, val); err == nil {
+			return temporal.SearchAttribute{Type: temporal.SearchAttributeDatetime, Value: t}
+		}
+		return temporal.SearchAttribute{Type: temporal.SearchAttributeKeyword, Value: val}
+	case float64:
+		if val == float64(int64(val)) {
+			return temporal.SearchAttribute{Type: temporal.SearchAttributeInt, Value: int64(val)}
+		}
+		return temporal.SearchAttribute{Type: temporal.SearchAttributeDouble, Value: val}
+	case bool:
+		return temporal.SearchAttribute{Type: temporal.SearchAttributeBool, Value: val}
+	case []any:
+		list := make([]string, len(val))
+		for i, item := range val {
+			list[i] = fmt.Sprint(item)
+		}
+		return temporal.SearchAttribute{Type: temporal.SearchAttributeKeywordList, Value: list}
+	}
+	return temporal.SearchAttribute{Value: v}
+}
+
+// SignalWithStartWorkflow signals a running workflow, starting it first if needed.
+func (p *Provider) SignalWithStartWorkflow(ctx context.Context, namespace string, req temporal.SignalWithStartRequest) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to signal with start workflow: %w", err)
+	}
+	if ws, err := p.findRunning(namespace, req.WorkflowID, ""); err == nil {
+		ws.appendSignal(req.SignalName, req.SignalInput)
+		return ws.workflow.RunID, nil
+	}
+	if req.WorkflowType == "" || req.TaskQueue == "" {
+		return "", fmt.Errorf("failed to signal with start workflow: workflow type and task queue are required")
+	}
+
+	ws := &workflowState{
+		workflow: temporal.Workflow{
+			ID:        req.WorkflowID,
+			RunID:     newRunID(),
+			Type:      req.WorkflowType,
+			Status:    temporal.StatusRunning,
+			Namespace: namespace,
+			TaskQueue: req.TaskQueue,
+			StartTime: time.Now(),
+			Input:     string(req.WorkflowInput),
+		},
+	}
+	ws.history = synthesizeHistory(ws.workflow)[:1]
+	ws.appendSignal(req.SignalName, req.SignalInput)
+	ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskScheduled", Details: "TaskQueue: " + req.TaskQueue, TaskQueue: req.TaskQueue})
+	ns.workflows = append(ns.workflows, ws)
+	return ws.workflow.RunID, nil
+}
+
+// UpdateWorkflow applies an update to a running workflow.
+// The mock handler accepts every update and echoes its arguments as the result.
+func (p *Provider) UpdateWorkflow(ctx context.Context, namespace string, req temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ws, err := p.findRunning(namespace, req.WorkflowID, req.RunID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	}
+	return ws.applyUpdate(req)
+}
+
+// UpdateWithStartWorkflow starts the workflow unless it is already running, then updates it.
+func (p *Provider) UpdateWithStartWorkflow(ctx context.Context, namespace string, start temporal.StartWorkflowRequest, update temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if start.WorkflowID == "" {
+		start.WorkflowID = newRunID()
+	}
+	if start.IDConflictPolicy == "" {
+		start.IDConflictPolicy = temporal.IDConflictUseExisting
+	}
+	runID, err := p.startWorkflow(namespace, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start: %w", err)
+	}
+
+	update.WorkflowID = start.WorkflowID
+	update.RunID = runID
+	ws, err := p.findRunning(namespace, update.WorkflowID, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start: %w", err)
+	}
+	return ws.applyUpdate(update)
+}
+
+// applyUpdate records accepted and completed update events and returns the outcome.
+func (ws *workflowState) applyUpdate(req temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	if req.UpdateName == "" {
+		return nil, fmt.Errorf("failed to update workflow: update name is required")
+	}
+	if req.UpdateID == "" {
+		req.UpdateID = newRunID()
+	}
+
+	result := "null"
+	if len(req.Input) > 0 {
+		var value interface{}
+		if err := json.Unmarshal(req.Input, &value); err != nil {
+			value = string(req.Input)
+		}
+		data, _ := json.MarshalIndent(value, "", "  ")
+		result = string(data)
+	}
+
+	details := fmt.Sprintf("UpdateId: %s, Name: %s", req.UpdateID, req.UpdateName)
+	if len(req.Input) > 0 {
+		details += ", Input: " + string(req.Input)
+	}
+	ws.append(temporal.EnhancedHistoryEvent{
+		Type:        "WorkflowExecutionUpdateAccepted",
+		Details:     details,
+		Identity:    "tempo",
+		UpdateID:    req.UpdateID,
+		UpdateName:  req.UpdateName,
+		UpdateInput: string(req.Input),
+	})
+	ws.append(temporal.EnhancedHistoryEvent{
+		Type:     "WorkflowExecutionUpdateCompleted",
+		Details:  fmt.Sprintf("UpdateId: %s, Result: %s", req.UpdateID, result),
+		UpdateID: req.UpdateID,
+		Result:   result,
+	})
+
+	return &temporal.UpdateResult{
+		UpdateID: req.UpdateID,
+		RunID:    ws.workflow.RunID,
+		Stage:    "Completed",
+		Result:   result,
+	}, nil
+}
+
+// DeleteWorkflow removes a workflow execution and its history.
+func (p *Provider) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	for i, candidate := range ns.workflows {
+		if candidate == ws {
+			ns.workflows = append(ns.workflows[:i], ns.workflows[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// ResetWorkflow creates a new run from the history up to a workflow task
+// completion, terminating the current run if it is still running.
+func (p *Provider) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return "", err
+	}
+	if eventID < 1 || eventID > int64(len(ws.history)) {
+		return "", fmt.Errorf("invalid reset event ID: %d", eventID)
+	}
+
+	now := time.Now()
+	newRun := &workflowState{
+		workflow: ws.workflow,
+		queries:  ws.queries,
+	}
+	newRun.workflow.RunID = newRunID()
+	newRun.workflow.StartTime = now
+	newRun.workflow.Status = temporal.StatusRunning
+	newRun.workflow.EndTime = nil
+	newRun.workflow.Output = ""
+	newRun.workflow.PrevRunID = ws.workflow.RunID
+	newRun.workflow.NextRunID = ""
+	// Keep everything before the workflow task completion, then fail that task
+	// with a reset cause so the new run continues from there.
+	newRun.history = append([]temporal.EnhancedHistoryEvent(nil), ws.history[:eventID-1]...)
+	newRun.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskFailed", Time: now, Details: "Cause: ResetWorkflow, BaseRunId: " + ws.workflow.RunID + ", Failure: " + reason, Failure: reason})
+	newRun.append(temporal.EnhancedHistoryEvent{Type: "WorkflowTaskScheduled", Time: now, Details: "TaskQueue: " + ws.workflow.TaskQueue, TaskQueue: ws.workflow.TaskQueue})
+
+	if ws.workflow.Status == temporal.StatusRunning {
+		ws.close(temporal.StatusTerminated)
+		ws.append(temporal.EnhancedHistoryEvent{Type: "WorkflowExecutionTerminated", Time: now, Details: "Reason: " + reason, Identity: "history-service"})
+	}
+	ns.workflows = append(ns.workflows, newRun)
+	return newRun.workflow.RunID, nil
+}
+
+// Activities
+
+// pendingActivity returns a pending activity of the run for modification.
+func (ws *workflowState) pendingActivity(activityID string) (*temporal.PendingActivity, error) {
+	for i := range ws.workflow.PendingActivities {
+		if ws.workflow.PendingActivities[i].ActivityID == activityID {
+			// Copy so workflows already handed out don't change underneath callers
+			ws.workflow.PendingActivities = slices.Clone(ws.workflow.PendingActivities)
+			return &ws.workflow.PendingActivities[i], nil
+		}
+	}
+	return nil, fmt.Errorf("activity %s is not pending", activityID)
+}
+
+// PauseActivity marks a pending activity as paused.
+func (p *Provider) PauseActivity(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	pa, err := ws.pendingActivity(activityID)
+	if err != nil {
+		return err
+	}
+	if pa.State == "Paused" {
+		return fmt.Errorf("activity %s is already paused", activityID)
+	}
+	pa.State = "Paused"
+	pa.NextAttemptScheduleTime = nil
+	return nil
+}
+
+// UnpauseActivity schedules a paused activity again.
+func (p *Provider) UnpauseActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetAttempts bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	pa, err := ws.pendingActivity(activityID)
+	if err != nil {
+		return err
+	}
+	if pa.State != "Paused" {
+		return fmt.Errorf("activity %s is not paused", activityID)
+	}
+	pa.State = "Scheduled"
+	if resetAttempts {
+		pa.Attempt = 1
+	}
+	now := time.Now()
+	pa.NextAttemptScheduleTime = &now
+	return nil
+}
+
+// ResetActivity starts a pending activity over from its first attempt.
+func (p *Provider) ResetActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetHeartbeat, keepPaused bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	pa, err := ws.pendingActivity(activityID)
+	if err != nil {
+		return err
+	}
+	pa.Attempt = 1
+	pa.CurrentRetryInterval = 0
+	pa.NextAttemptScheduleTime = nil
+	if pa.State != "Paused" || !keepPaused {
+		pa.State = "Scheduled"
+	}
+	if resetHeartbeat {
+		pa.HeartbeatDetails = ""
+		pa.LastHeartbeatTime = nil
+	}
+	return nil
+}
+
+// UpdateActivityOptions merges the non-zero fields of opts into a pending activity's options.
+func (p *Provider) UpdateActivityOptions(ctx context.Context, namespace, workflowID, runID, activityID string, opts temporal.ActivityOptions) (*temporal.ActivityOptions, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if opts == (temporal.ActivityOptions{}) {
+		return nil, fmt.Errorf("no activity options to update")
+	}
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	pa, err := ws.pendingActivity(activityID)
+	if err != nil {
+		return nil, err
+	}
+
+	var merged temporal.ActivityOptions
+	if pa.Options != nil {
+		merged = *pa.Options
+	}
+	for _, f := range []struct{ dst, src *time.Duration }{
+		{&merged.ScheduleToCloseTimeout, &opts.ScheduleToCloseTimeout},
+		{&merged.ScheduleToStartTimeout, &opts.ScheduleToStartTimeout},
+		{&merged.StartToCloseTimeout, &opts.StartToCloseTimeout},
+		{&merged.HeartbeatTimeout, &opts.HeartbeatTimeout},
+		{&merged.InitialInterval, &opts.InitialInterval},
+		{&merged.MaximumInterval, &opts.MaximumInterval},
+	} {
+		if *f.src > 0 {
+			*f.dst = *f.src
+		}
+	}
+	if opts.BackoffCoefficient > 0 {
+		merged.BackoffCoefficient = opts.BackoffCoefficient
+	}
+	if opts.MaximumAttempts > 0 {
+		merged.MaximumAttempts = opts.MaximumAttempts
+		pa.MaximumAttempts = opts.MaximumAttempts
+	}
+	pa.Options = &merged
+
+	result := merged
+	return &result, nil
+}
+
+// Schedules
+
+// ListSchedules returns the schedules in a namespace.
+func (p *Provider) ListSchedules(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Schedule, string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list schedules: %w", err)
+	}
+	schedules := make([]temporal.Schedule, len(ns.schedules))
+	for i, s := range ns.schedules {
+		schedules[i] = *s
+		schedules[i].Definition = nil // Only described schedules carry their definition
+	}
+	return paginate(schedules, opts)
+}
+
+// GetSchedule returns details for a specific schedule.
+func (p *Provider) GetSchedule(ctx context.Context, namespace, scheduleID string) (*temporal.Schedule, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, i, err := p.findSchedule(namespace, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe schedule: %w", err)
+	}
+	s := *ns.schedules[i]
+	return &s, nil
+}
+
+// PauseSchedule pauses a schedule.
+func (p *Provider) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return p.setSchedulePaused(namespace, scheduleID, true, reason)
+}
+
+// UnpauseSchedule unpauses a schedule.
+func (p *Provider) UnpauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return p.setSchedulePaused(namespace, scheduleID, false, reason)
+}
+
+func (p *Provider) setSchedulePaused(namespace, scheduleID string, paused bool, note string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, i, err := p.findSchedule(namespace, scheduleID)
+	if err != nil {
+		return err
+	}
+	s := ns.schedules[i]
+	s.Paused = paused
+	s.Notes = note
+	if s.Definition != nil {
+		def := *s.Definition
+		def.Paused = paused
+		def.Notes = note
+		s.Definition = &def
+	}
+	return nil
+}
+
+// TriggerSchedule starts the schedule's workflow action immediately.
+func (p *Provider) TriggerSchedule(ctx context.Context, namespace, scheduleID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, i, err := p.findSchedule(namespace, scheduleID)
+	if err != nil {
+		return err
+	}
+	s := ns.schedules[i]
+
+	now := time.Now()
+	baseID := s.WorkflowID
+	if baseID == "" {
+		baseID = s.ID
+	}
+	wf := temporal.Workflow{
+		ID:        fmt.Sprintf("%s-%s", baseID, now.UTC().Format(time.RFC3339)),
+		RunID:     newRunID(),
+		Type:      s.WorkflowType,
+		Status:    temporal.StatusRunning,
+		Namespace: namespace,
+		TaskQueue: s.TaskQueue,
+		StartTime: now,
+	}
+	ns.workflows = append(ns.workflows, &workflowState{workflow: wf, history: synthesizeHistory(wf)})
+
+	s.LastRunTime = &now
+	s.LastRunStatus = temporal.StatusRunning
+	s.TotalActions++
+	s.RecentActions++
+	return nil
+}
+
+// DeleteSchedule removes a schedule.
+func (p *Provider) DeleteSchedule(ctx context.Context, namespace, scheduleID string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, i, err := p.findSchedule(namespace, scheduleID)
+	if err != nil {
+		return err
+	}
+	ns.schedules = append(ns.schedules[:i], ns.schedules[i+1:]...)
+	return nil
+}
+
+// CreateSchedule adds a schedule built from def.
+func (p *Provider) CreateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	if err := def.Validate(); err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+	if _, _, err := p.findSchedule(namespace, def.ID); err == nil {
+		return fmt.Errorf("failed to create schedule: schedule already exists: %s", def.ID)
+	}
+
+	s := &temporal.Schedule{ID: def.ID}
+	applyScheduleDefinition(s, def, time.Now())
+	ns.schedules = append(ns.schedules, s)
+	return nil
+}
+
+// UpdateSchedule replaces a schedule's definition, keeping its run history.
+func (p *Provider) UpdateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	if err := def.Validate(); err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ns, i, err := p.findSchedule(namespace, def.ID)
+	if err != nil {
+		return err
+	}
+	applyScheduleDefinition(ns.schedules[i], def, time.Now())
+	return nil
+}
+
+// applyScheduleDefinition sets a schedule's summary fields from def, as the server
+// reports them when describing it.
+func applyScheduleDefinition(s *temporal.Schedule, def temporal.ScheduleDefinition, now time.Time) {
+	if def.Action.WorkflowID == "" {
+		def.Action.WorkflowID = def.ID
+	}
+	s.Spec = def.Spec.String()
+	s.WorkflowType = def.Action.WorkflowType
+	s.WorkflowID = def.Action.WorkflowID
+	s.TaskQueue = def.Action.TaskQueue
+	s.Paused = def.Paused
+	s.Notes = def.Notes
+	s.OverlapPolicy = def.Policy.Overlap
+	if s.OverlapPolicy == "" {
+		s.OverlapPolicy = temporal.ScheduleOverlapSkip
+	}
+
+	// Only intervals are simple enough to predict; other specs keep the previous next run
+	if len(def.Spec.Intervals) > 0 {
+		interval := def.Spec.Intervals[0]
+		next := now.Truncate(interval.Every).Add(interval.Offset)
+		if !next.After(now) {
+			next = next.Add(interval.Every)
+		}
+		s.NextRunTime = &next
+	}
+	if def.Paused {
+		s.NextRunTime = nil
+	}
+	s.Definition = &def
+}
+
+// Query Operations
+
+// QueryWorkflow answers queries from the fixture's query results.
+// The built-in __stack_trace query is always available.
+func (p *Provider) QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*temporal.QueryResult, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ws, err := p.findWorkflow(namespace, workflowID, runID)
+	if err != nil {
+		return &temporal.QueryResult{QueryType: queryType, Error: err.Error()}, nil
+	}
+
+	if queryType == "__stack_trace" {
+		return &temporal.QueryResult{
+			QueryType: queryType,
+			Result:    fmt.Sprintf("coroutine root [blocked on selector]:\nmain.%s(...)\n\tmock/workflow.go:42", ws.workflow.Type),
+		}, nil
+	}
+
+	raw, ok := ws.queries[queryType]
+	if !ok {
+		known := []string{"__stack_trace"}
+		for name := range ws.queries {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		return &temporal.QueryResult{
+			QueryType: queryType,
+			Error:     fmt.Sprintf("unknown queryType %s. KnownQueryTypes=[%s]", queryType, strings.Join(known, " ")),
+		}, nil
+	}
+
+	var result interface{}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return &temporal.QueryResult{QueryType: queryType, Result: string(raw)}, nil
+	}
+	resultJSON, _ := json.MarshalIndent(result, "", "  ")
+	return &temporal.QueryResult{QueryType: queryType, Result: string(resultJSON)}, nil
+}
+
+// Batch Operations
+
+// CancelWorkflows cancels multiple workflows and returns results for each.
+func (p *Provider) CancelWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier) ([]temporal.BatchResult, error) {
+	results := make([]temporal.BatchResult, len(workflows))
+	for i, wf := range workflows {
+		err := p.CancelWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, "")
+		results[i] = batchResult(wf, err)
+	}
+	return results, nil
+}
+
+// TerminateWorkflows terminates multiple workflows and returns results for each.
+func (p *Provider) TerminateWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier, reason string) ([]temporal.BatchResult, error) {
+	results := make([]temporal.BatchResult, len(workflows))
+	for i, wf := range workflows {
+		err := p.TerminateWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, reason)
+		results[i] = batchResult(wf, err)
+	}
+	return results, nil
+}
+
+func batchResult(wf temporal.WorkflowIdentifier, err error) temporal.BatchResult {
+	result := temporal.BatchResult{
+		WorkflowID: wf.WorkflowID,
+		RunID:      wf.RunID,
+		Success:    err == nil,
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// GetResetPoints returns valid reset points for a workflow execution.
+func (p *Provider) GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]temporal.ResetPoint, error) {
+	events, err := p.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	if err != nil {
+		return nil, err
+	}
+	return temporal.ResetPointsFromHistory(events), nil
+}
+
+// CountWorkflows returns the number of workflows matching a visibility query, grouped
+// by execution status when asked, the only grouping the server supports.
+func (p *Provider) CountWorkflows(ctx context.Context, namespace, query, groupBy string) (int64, []temporal.WorkflowCountGroup, error) {
+	if groupBy != "" && groupBy != "ExecutionStatus" {
+		return 0, nil, fmt.Errorf("failed to count workflows: grouping by %q is not supported", groupBy)
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count workflows: %w", err)
+	}
+	matches, err := matching(ns, query)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count workflows: %w", err)
+	}
+	if groupBy == "" {
+		return int64(len(matches)), nil, nil
+	}
+
+	var groups []temporal.WorkflowCountGroup
+	for _, ws := range matches {
+		i := slices.IndexFunc(groups, func(g temporal.WorkflowCountGroup) bool {
+			return g.Value == ws.workflow.Status
+		})
+		if i < 0 {
+			groups = append(groups, temporal.WorkflowCountGroup{Value: ws.workflow.Status})
+			i = len(groups) - 1
+		}
+		groups[i].Count++
+	}
+	return int64(len(matches)), groups, nil
+}
+
+// StartBatchOperation applies the operation to every matching workflow immediately.
+// Workflows the operation does not apply to (e.g. closed ones for terminate) are skipped.
+func (p *Provider) StartBatchOperation(ctx context.Context, namespace string, req temporal.BatchOperationRequest) (string, error) {
+	if req.Query == "" {
+		return "", fmt.Errorf("failed to start batch operation: a visibility query is required")
+	}
+	switch req.Type {
+	case temporal.BatchTerminate, temporal.BatchCancel, temporal.BatchSignal, temporal.BatchDelete, temporal.BatchReset:
+	default:
+		return "", fmt.Errorf("failed to start batch operation: unknown operation %q", req.Type)
+	}
+
+	p.mu.RLock()
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		p.mu.RUnlock()
+		return "", fmt.Errorf("failed to start batch operation: %w", err)
+	}
+	matches, err := matching(ns, req.Query)
+	if err != nil {
+		p.mu.RUnlock()
+		return "", fmt.Errorf("failed to start batch operation: %w", err)
+	}
+	targets := make([]temporal.Workflow, len(matches))
+	resetEvents := make([]int64, len(matches))
+	for i, ws := range matches {
+		targets[i] = ws.workflow
+		resetEvents[i] = resetEventID(ws.history, req.ResetType)
+	}
+	p.mu.RUnlock()
+
+	job := &temporal.BatchOperation{
+		JobID:      req.JobID,
+		Type:       string(req.Type),
+		State:      temporal.BatchStateCompleted,
+		StartTime:  time.Now(),
+		TotalCount: int64(len(targets)),
+		Reason:     req.Reason,
+		Identity:   "tempo",
+	}
+	if job.JobID == "" {
+		job.JobID = newRunID()
+	}
+
+	for i, wf := range targets {
+		var err error
+		switch req.Type {
+		case temporal.BatchTerminate:
+			err = p.TerminateWorkflow(ctx, namespace, wf.ID, wf.RunID, req.Reason)
+		case temporal.BatchCancel:
+			err = p.CancelWorkflow(ctx, namespace, wf.ID, wf.RunID, req.Reason)
+		case temporal.BatchSignal:
+			err = p.SignalWorkflow(ctx, namespace, wf.ID, wf.RunID, req.SignalName, req.SignalInput)
+		case temporal.BatchDelete:
+			err = p.DeleteWorkflow(ctx, namespace, wf.ID, wf.RunID)
+		case temporal.BatchReset:
+			if resetEvents[i] == 0 {
+				err = fmt.Errorf("no reset point")
+				break
+			}
+			_, err = p.ResetWorkflow(ctx, namespace, wf.ID, wf.RunID, resetEvents[i], req.Reason)
+		}
+		if err != nil {
+			job.FailureCount++
+		} else {
+			job.CompletedCount++
+		}
+	}
+
+	closeTime := time.Now()
+	job.CloseTime = &closeTime
+
+	p.mu.Lock()
+	ns.batchJobs = append(ns.batchJobs, job)
+	p.mu.Unlock()
+	return job.JobID, nil
+}
+
+// resetEventID returns the first or last completed workflow task in a history.
+func resetEventID(history []temporal.EnhancedHistoryEvent, resetType string) int64 {
+	var eventID int64
+	for _, ev := range history {
+		if ev.Type != "WorkflowTaskCompleted" {
+			continue
+		}
+		eventID = ev.ID
+		if resetType == temporal.ResetFirstWorkflowTask {
+			break
+		}
+	}
+	return eventID
+}
+
+// ListBatchOperations returns the batch jobs started in a namespace, newest first.
+func (p *Provider) ListBatchOperations(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.BatchOperation, string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list batch operations: %w", err)
+	}
+	ops := make([]temporal.BatchOperation, len(ns.batchJobs))
+	for i, job := range ns.batchJobs {
+		ops[i] = temporal.BatchOperation{
+			JobID:     job.JobID,
+			State:     job.State,
+			StartTime: job.StartTime,
+			CloseTime: job.CloseTime,
+		}
+	}
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].StartTime.After(ops[j].StartTime)
+	})
+	return paginate(ops, opts)
+}
+
+// DescribeBatchOperation returns a batch job with its progress counts.
+func (p *Provider) DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*temporal.BatchOperation, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	job, err := p.findBatchJob(namespace, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe batch operation: %w", err)
+	}
+	op := *job
+	return &op, nil
+}
+
+// StopBatchOperation stops a running batch job. Jobs started against the mock
+// complete immediately, so only jobs seeded as running can be stopped.
+func (p *Provider) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	job, err := p.findBatchJob(namespace, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to stop batch operation: %w", err)
+	}
+	if job.State != temporal.BatchStateRunning {
+		return fmt.Errorf("failed to stop batch operation: batch operation %s is not running", jobID)
+	}
+	now := time.Now()
+	job.State = temporal.BatchStateFailed
+	job.CloseTime = &now
+	job.Reason = reason
+	return nil
+}
+
+func (p *Provider) findBatchJob(namespace, jobID string) (*temporal.BatchOperation, error) {
+	ns, err := p.namespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range ns.batchJobs {
+		if job.JobID == jobID {
+			return job, nil
+		}
+	}
+	return nil, fmt.Errorf("batch operation %s not found", jobID)
+}
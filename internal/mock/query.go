@@ -0,0 +1,211 @@
+package mock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// matchQuery reports whether a workflow satisfies a visibility query.
+// Only the common subset of the visibility grammar is understood: clauses of the
+// form `Attr op 'value'` (=, !=, >, >=, <, <=, STARTS_WITH, IN (...)) joined by
+// AND / OR. Parentheses are ignored and ORDER BY is dropped.
+func matchQuery(query string, w *temporal.Workflow) (bool, error) {
+	query = strings.TrimSpace(query)
+	if idx := indexFold(query, " ORDER BY "); idx >= 0 {
+		query = query[:idx]
+	}
+	query = strings.NewReplacer("(", " ", ")", " ").Replace(protectInLists(query))
+	if strings.TrimSpace(query) == "" {
+		return true, nil
+	}
+
+	for _, group := range splitFold(query, " OR ") {
+		matched := true
+		for _, clause := range splitFold(group, " AND ") {
+			ok, err := matchClause(strings.TrimSpace(clause), w)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// protectInLists rewrites `IN ('a', 'b')` as `IN ['a'|'b']` so the list survives
+// parenthesis stripping and comma splitting.
+func protectInLists(query string) string {
+	var b strings.Builder
+	for {
+		idx := indexFold(query, " IN (")
+		if idx < 0 {
+			b.WriteString(query)
+			return b.String()
+		}
+		end := strings.Index(query[idx:], ")")
+		if end < 0 {
+			b.WriteString(query)
+			return b.String()
+		}
+		list := query[idx+len(" IN (") : idx+end]
+		b.WriteString(query[:idx])
+		b.WriteString(" IN [" + strings.ReplaceAll(list, ",", "|") + "]")
+		query = query[idx+end+1:]
+	}
+}
+
+func matchClause(clause string, w *temporal.Workflow) (bool, error) {
+	for _, op := range []string{" STARTS_WITH ", " IN ", "!=", ">=", "<=", "=", ">", "<"} {
+		idx := indexFold(clause, op)
+		if idx < 0 {
+			continue
+		}
+		attr := strings.TrimSpace(clause[:idx])
+		value := strings.TrimSpace(clause[idx+len(op):])
+		actual, isTime, err := workflowAttribute(w, attr)
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.TrimSpace(op) {
+		case "STARTS_WITH":
+			return strings.HasPrefix(actual, unquote(value)), nil
+		case "IN":
+			for _, v := range strings.Split(strings.Trim(value, "[]"), "|") {
+				if actual == unquote(strings.TrimSpace(v)) {
+					return true, nil
+				}
+			}
+			return false, nil
+		case "=":
+			return actual == unquote(value), nil
+		case "!=":
+			return actual != unquote(value), nil
+		}
+
+		if !isTime {
+			return compareOrdered(op, strings.Compare(actual, unquote(value))), nil
+		}
+		if actual == "" {
+			return false, nil
+		}
+		want, err := time.Parse(time.RFC3339, unquote(value))
+		if err != nil {
+			return false, fmt.Errorf("invalid query: %q is not an RFC3339 time", unquote(value))
+		}
+		got, _ := time.Parse(time.RFC3339Nano, actual)
+		return compareOrdered(op, got.Compare(want)), nil
+	}
+	return false, fmt.Errorf("invalid query: unsupported expression %q", clause)
+}
+
+func compareOrdered(op string, cmp int) bool {
+	switch strings.TrimSpace(op) {
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp <= 0
+	}
+}
+
+// workflowAttribute returns the value of a visibility attribute for a workflow,
+// and whether it is a datetime attribute.
+func workflowAttribute(w *temporal.Workflow, attr string) (string, bool, error) {
+	switch strings.Trim(attr, "`") {
+	case "WorkflowId":
+		return w.ID, false, nil
+	case "RunId":
+		return w.RunID, false, nil
+	case "WorkflowType":
+		return w.Type, false, nil
+	case "ExecutionStatus":
+		return w.Status, false, nil
+	case "TaskQueue":
+		return w.TaskQueue, false, nil
+	case "ParentWorkflowId":
+		if w.ParentID == nil {
+			return "", false, nil
+		}
+		return *w.ParentID, false, nil
+	case "StartTime":
+		return w.StartTime.Format(time.RFC3339Nano), true, nil
+	case "CloseTime":
+		if w.EndTime == nil {
+			return "", true, nil
+		}
+		return w.EndTime.Format(time.RFC3339Nano), true, nil
+	}
+	if !isCustomSearchAttribute(strings.Trim(attr, "`")) {
+		return "", false, fmt.Errorf("invalid query: unknown search attribute %q", attr)
+	}
+	// Custom attributes match nothing on workflows that don't set them
+	sa := w.SearchAttributes[strings.Trim(attr, "`")]
+	if t, isTime := sa.Value.(time.Time); isTime {
+		return t.Format(time.RFC3339Nano), true, nil
+	}
+	if sa.Value == nil {
+		return "", sa.Type == temporal.SearchAttributeDatetime, nil
+	}
+	return sa.String(), false, nil
+}
+
+// isCustomSearchAttribute reports whether name could be a custom search attribute:
+// an identifier that isn't one of the server's built-in attributes.
+func isCustomSearchAttribute(name string) bool {
+	if name == "" || strings.ContainsAny(name, " '\"()") {
+		return false
+	}
+	switch name {
+	case "ExecutionTime", "ExecutionDuration", "HistoryLength", "HistorySizeBytes", "StateTransitionCount", "BuildIds", "TemporalChangeVersion":
+		return false
+	}
+	return true
+}
+
+// unquote strips a value's quotes, undoing doubled quotes and backslash escapes inside it.
+func unquote(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] {
+		return s
+	}
+	q, inner := s[0], s[1:len(s)-1]
+	var b strings.Builder
+	for i := 0; i < len(inner); i++ {
+		if i+1 < len(inner) && (inner[i] == '\\' || (inner[i] == q && inner[i+1] == q)) {
+			i++
+		}
+		b.WriteByte(inner[i])
+	}
+	return b.String()
+}
+
+// indexFold is a case-insensitive strings.Index.
+func indexFold(s, substr string) int {
+	return strings.Index(strings.ToUpper(s), strings.ToUpper(substr))
+}
+
+// splitFold splits s around each case-insensitive occurrence of sep.
+func splitFold(s, sep string) []string {
+	var parts []string
+	for {
+		idx := indexFold(s, sep)
+		if idx < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:idx])
+		s = s[idx+len(sep):]
+	}
+}
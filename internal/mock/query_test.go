@@ -0,0 +1,84 @@
+package mock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+func TestMatchQuery(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	parent := "order-parent"
+	w := &temporal.Workflow{
+		ID:        "order-42",
+		RunID:     "run-1",
+		Type:      "OrderWorkflow",
+		Status:    "Completed",
+		TaskQueue: "orders",
+		StartTime: start,
+		EndTime:   &end,
+		ParentID:  &parent,
+		SearchAttributes: map[string]temporal.SearchAttribute{
+			"CustomerId": {Type: temporal.SearchAttributeKeyword, Value: "o'brien"},
+			"Region":     {Type: temporal.SearchAttributeKeyword, Value: "eu"},
+			"Deadline":   {Type: temporal.SearchAttributeDatetime, Value: end},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		want    bool
+		wantErr bool
+	}{
+		{"empty query matches everything", "", true, false},
+		{"equals", "WorkflowId = 'order-42'", true, false},
+		{"equals mismatch", "WorkflowId = 'order-43'", false, false},
+		{"double quotes", `WorkflowType = "OrderWorkflow"`, true, false},
+		{"not equals", "ExecutionStatus != 'Running'", true, false},
+		{"keywords are case-insensitive", "WorkflowId = 'order-42' and TaskQueue = 'orders'", true, false},
+		{"AND needs every clause", "WorkflowId = 'order-42' AND TaskQueue = 'billing'", false, false},
+		{"OR needs one group", "TaskQueue = 'billing' OR ExecutionStatus = 'Completed'", true, false},
+		{"parentheses are ignored", "(WorkflowId = 'x' OR WorkflowId = 'order-42') AND Region = 'eu'", true, false},
+		{"STARTS_WITH", "WorkflowId STARTS_WITH 'order-'", true, false},
+		{"STARTS_WITH mismatch", "WorkflowId STARTS_WITH 'invoice-'", false, false},
+		{"IN", "ExecutionStatus IN ('Failed', 'Completed')", true, false},
+		{"IN mismatch", "ExecutionStatus IN ('Failed', 'Running')", false, false},
+		{"ORDER BY is dropped", "WorkflowType = 'OrderWorkflow' ORDER BY StartTime DESC", true, false},
+		{"doubled quote escape", "CustomerId = 'o''brien'", true, false},
+		{"backslash escape", `CustomerId = 'o\'brien'`, true, false},
+		{"quoted by the visibility helper", "CustomerId = " + temporal.QuoteVisibilityValue("o'brien"), true, false},
+		{"backquoted attribute", "`Region` = 'eu'", true, false},
+		{"parent", "ParentWorkflowId = 'order-parent'", true, false},
+		{"time after", "StartTime > '2026-03-01T00:00:00Z'", true, false},
+		{"time before", "StartTime < '2026-03-01T00:00:00Z'", false, false},
+		{"time inclusive", "CloseTime <= '2026-03-01T13:00:00Z'", true, false},
+		{"custom datetime", "Deadline >= '2026-03-01T13:00:00Z'", true, false},
+		{"unset custom attribute matches nothing", "Priority = 'high'", false, false},
+		{"invalid time", "StartTime > 'yesterday'", false, true},
+		{"unsupported builtin attribute", "HistoryLength > '10'", false, true},
+		{"unsupported expression", "WorkflowId", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchQuery(tt.query, w)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("matchQuery(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("matchQuery(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchQueryOpenWorkflow(t *testing.T) {
+	w := &temporal.Workflow{ID: "running", Status: "Running", StartTime: time.Now()}
+	for _, query := range []string{"CloseTime > '2020-01-01T00:00:00Z'", "CloseTime < '2100-01-01T00:00:00Z'"} {
+		if ok, err := matchQuery(query, w); err != nil || ok {
+			t.Errorf("matchQuery(%q) = %v, %v; open workflows have no close time", query, ok, err)
+		}
+	}
+}
@@ -0,0 +1,409 @@
+package session
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// maxEntrySize bounds a single session line; large histories can be several MB.
+const maxEntrySize = 64 * 1024 * 1024
+
+// ErrEndOfRecording is returned when a call is made more often than it was recorded.
+var ErrEndOfRecording = errors.New("end of recording")
+
+// Player is a Provider that serves results from a recorded session file.
+// Repeated calls with the same arguments return the recorded results in order and
+// fail with ErrEndOfRecording once they run out; following a history instead waits
+// for the context, as an idle long-poll would. Calls that were never recorded fail.
+type Player struct {
+	mu      sync.Mutex
+	config  temporal.ConnectionConfig
+	calls   map[string][]Entry
+	cursors map[string]int
+	// lists holds the latest list call per method, namespace, page and query with
+	// resolved times blanked out, used when a query differs from the recording only
+	// by the times its placeholders resolved to.
+	lists map[string]Entry
+}
+
+// Ensure Player implements temporal.Provider.
+var _ temporal.Provider = (*Player)(nil)
+
+// NewPlayer loads a session file for replay.
+func NewPlayer(path string) (*Player, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session file: %w", err)
+	}
+	defer f.Close()
+
+	p := &Player{
+		calls:   make(map[string][]Entry),
+		cursors: make(map[string]int),
+		lists:   make(map[string]Entry),
+	}
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEntrySize)
+	configSeen := false
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			return nil, fmt.Errorf("failed to parse session file line %d: %w", line, err)
+		}
+
+		if e.Method == "Config" {
+			if !configSeen {
+				_ = json.Unmarshal(e.Result, &p.config)
+				configSeen = true
+			}
+			continue
+		}
+
+		k := key(e.Method, e.Args)
+		p.calls[k] = append(p.calls[k], e)
+		if lk, ok := listKey(e.Method, e.Args); ok {
+			p.lists[lk] = e
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read session file: %w", err)
+	}
+	return p, nil
+}
+
+// resolvedTime matches the quoted times that query placeholders such as $TODAY resolve to.
+var resolvedTime = regexp.MustCompile(`'\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})'`)
+
+// listKey identifies a list call by namespace, page size, page token and query,
+// ignoring the times placeholders resolved to.
+func listKey(method string, args json.RawMessage) (string, bool) {
+	if method != "ListWorkflows" && method != "ListSchedules" {
+		return "", false
+	}
+	var namespace string
+	var opts temporal.ListOptions
+	if err := json.Unmarshal(args, &[]any{&namespace, &opts}); err != nil {
+		return "", false
+	}
+	opts.Query = resolvedTime.ReplaceAllString(opts.Query, "$$TIME")
+	k, err := json.Marshal([]any{namespace, opts})
+	if err != nil {
+		return "", false
+	}
+	return key(method, k), true
+}
+
+// next returns the recorded entry for a call.
+func (p *Player) next(method string, args ...any) (Entry, error) {
+	encArgs, err := encodeArgs(args...)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	k := key(method, encArgs)
+	entries := p.calls[k]
+	if idx := p.cursors[k]; idx < len(entries) {
+		p.cursors[k] = idx + 1
+		return entries[idx], nil
+	}
+
+	// Lists are refreshed on a timer, so they repeat the latest matching page
+	if lk, ok := listKey(method, encArgs); ok {
+		if fallback, ok := p.lists[lk]; ok {
+			return fallback, nil
+		}
+	}
+	if len(entries) > 0 {
+		return Entry{}, fmt.Errorf("%w: %s was called more often than recorded", ErrEndOfRecording, method)
+	}
+	return Entry{}, fmt.Errorf("%s was not recorded in this session", method)
+}
+
+// replay decodes the recorded result of a call.
+func replay[T any](p *Player, method string, args ...any) (T, error) {
+	var result T
+	e, err := p.next(method, args...)
+	if err != nil {
+		return result, err
+	}
+	if e.Error != "" {
+		return result, errors.New(e.Error)
+	}
+	if len(e.Result) > 0 {
+		if err := json.Unmarshal(e.Result, &result); err != nil {
+			return result, fmt.Errorf("failed to decode recorded %s result: %w", method, err)
+		}
+	}
+	return result, nil
+}
+
+// replayErr replays a call that only returns an error.
+func replayErr(p *Player, method string, args ...any) error {
+	_, err := replay[json.RawMessage](p, method, args...)
+	return err
+}
+
+// Connection Management
+
+// Close is a no-op; a replay session holds no connection.
+func (p *Player) Close() error {
+	return nil
+}
+
+// IsConnected always reports true so views behave as they did when recorded.
+func (p *Player) IsConnected() bool {
+	return true
+}
+
+// CheckConnection always succeeds.
+func (p *Player) CheckConnection(ctx context.Context) error {
+	return nil
+}
+
+// Reconnect is a no-op.
+func (p *Player) Reconnect(ctx context.Context) error {
+	return nil
+}
+
+// ReconnectWithConfig records the new config; the replayed data is unchanged.
+func (p *Player) ReconnectWithConfig(ctx context.Context, config temporal.ConnectionConfig) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+	return nil
+}
+
+// Config returns the connection config captured when the session was recorded.
+func (p *Player) Config() temporal.ConnectionConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.config
+}
+
+// Namespaces
+
+func (p *Player) ListNamespaces(ctx context.Context) ([]temporal.Namespace, error) {
+	return replay[[]temporal.Namespace](p, "ListNamespaces")
+}
+
+func (p *Player) CreateNamespace(ctx context.Context, req temporal.NamespaceCreateRequest) error {
+	return replayErr(p, "CreateNamespace", req)
+}
+
+func (p *Player) DescribeNamespace(ctx context.Context, name string) (*temporal.NamespaceDetail, error) {
+	return replay[*temporal.NamespaceDetail](p, "DescribeNamespace", name)
+}
+
+func (p *Player) UpdateNamespace(ctx context.Context, req temporal.NamespaceUpdateRequest) error {
+	return replayErr(p, "UpdateNamespace", req)
+}
+
+func (p *Player) DeprecateNamespace(ctx context.Context, name string) error {
+	return replayErr(p, "DeprecateNamespace", name)
+}
+
+func (p *Player) DeleteNamespace(ctx context.Context, name string) error {
+	return replayErr(p, "DeleteNamespace", name)
+}
+
+func (p *Player) ListSearchAttributes(ctx context.Context, namespace string) ([]temporal.SearchAttributeInfo, error) {
+	return replay[[]temporal.SearchAttributeInfo](p, "ListSearchAttributes", namespace)
+}
+
+func (p *Player) AddSearchAttributes(ctx context.Context, namespace string, attributes map[string]string) error {
+	return replayErr(p, "AddSearchAttributes", namespace, attributes)
+}
+
+func (p *Player) RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error {
+	return replayErr(p, "RemoveSearchAttributes", namespace, names)
+}
+
+// Workflows
+
+func (p *Player) ListWorkflows(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Workflow, string, error) {
+	page, err := replay[pageResult[temporal.Workflow]](p, "ListWorkflows", namespace, opts)
+	return page.Items, page.NextPageToken, err
+}
+
+func (p *Player) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*temporal.Workflow, error) {
+	return replay[*temporal.Workflow](p, "GetWorkflow", namespace, workflowID, runID)
+}
+
+func (p *Player) GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.HistoryEvent, error) {
+	return replay[[]temporal.HistoryEvent](p, "GetWorkflowHistory", namespace, workflowID, runID)
+}
+
+func (p *Player) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.EnhancedHistoryEvent, error) {
+	return replay[[]temporal.EnhancedHistoryEvent](p, "GetEnhancedWorkflowHistory", namespace, workflowID, runID)
+}
+
+func (p *Player) GetWorkflowHistoryPage(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	return replay[*temporal.HistoryPage](p, "GetWorkflowHistoryPage", namespace, workflowID, runID, pageToken)
+}
+
+func (p *Player) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	return replay[[]byte](p, "ExportWorkflowHistory", namespace, workflowID, runID)
+}
+
+func (p *Player) FollowWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	page, err := replay[*temporal.HistoryPage](p, "FollowWorkflowHistory", namespace, workflowID, runID, pageToken)
+	if errors.Is(err, ErrEndOfRecording) {
+		// Nothing else happened while recording; wait like a long-poll with no new events
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return page, err
+}
+
+func (p *Player) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*temporal.TaskQueueInfo, []temporal.Poller, error) {
+	result, err := replay[taskQueueResult[*temporal.TaskQueueInfo, temporal.Poller]](p, "DescribeTaskQueue", namespace, taskQueue)
+	return result.Info, result.Pollers, err
+}
+
+// Workflow Mutations
+
+func (p *Player) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	return replayErr(p, "CancelWorkflow", namespace, workflowID, runID, reason)
+}
+
+func (p *Player) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	return replayErr(p, "TerminateWorkflow", namespace, workflowID, runID, reason)
+}
+
+func (p *Player) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	return replayErr(p, "SignalWorkflow", namespace, workflowID, runID, signalName, input)
+}
+
+func (p *Player) StartWorkflow(ctx context.Context, namespace string, req temporal.StartWorkflowRequest) (string, error) {
+	return replay[string](p, "StartWorkflow", namespace, req)
+}
+
+func (p *Player) SignalWithStartWorkflow(ctx context.Context, namespace string, req temporal.SignalWithStartRequest) (string, error) {
+	return replay[string](p, "SignalWithStartWorkflow", namespace, req)
+}
+
+func (p *Player) UpdateWorkflow(ctx context.Context, namespace string, req temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	return replay[*temporal.UpdateResult](p, "UpdateWorkflow", namespace, req)
+}
+
+func (p *Player) UpdateWithStartWorkflow(ctx context.Context, namespace string, start temporal.StartWorkflowRequest, update temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	return replay[*temporal.UpdateResult](p, "UpdateWithStartWorkflow", namespace, start, update)
+}
+
+func (p *Player) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
+	return replayErr(p, "DeleteWorkflow", namespace, workflowID, runID)
+}
+
+func (p *Player) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error) {
+	return replay[string](p, "ResetWorkflow", namespace, workflowID, runID, eventID, reason)
+}
+
+// Activities
+
+func (p *Player) PauseActivity(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	return replayErr(p, "PauseActivity", namespace, workflowID, runID, activityID, reason)
+}
+
+func (p *Player) UnpauseActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetAttempts bool) error {
+	return replayErr(p, "UnpauseActivity", namespace, workflowID, runID, activityID, resetAttempts)
+}
+
+func (p *Player) ResetActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetHeartbeat, keepPaused bool) error {
+	return replayErr(p, "ResetActivity", namespace, workflowID, runID, activityID, resetHeartbeat, keepPaused)
+}
+
+func (p *Player) UpdateActivityOptions(ctx context.Context, namespace, workflowID, runID, activityID string, opts temporal.ActivityOptions) (*temporal.ActivityOptions, error) {
+	return replay[*temporal.ActivityOptions](p, "UpdateActivityOptions", namespace, workflowID, runID, activityID, opts)
+}
+
+// Schedules
+
+func (p *Player) ListSchedules(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Schedule, string, error) {
+	page, err := replay[pageResult[temporal.Schedule]](p, "ListSchedules", namespace, opts)
+	return page.Items, page.NextPageToken, err
+}
+
+func (p *Player) GetSchedule(ctx context.Context, namespace, scheduleID string) (*temporal.Schedule, error) {
+	return replay[*temporal.Schedule](p, "GetSchedule", namespace, scheduleID)
+}
+
+func (p *Player) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return replayErr(p, "PauseSchedule", namespace, scheduleID, reason)
+}
+
+func (p *Player) UnpauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	return replayErr(p, "UnpauseSchedule", namespace, scheduleID, reason)
+}
+
+func (p *Player) TriggerSchedule(ctx context.Context, namespace, scheduleID string) error {
+	return replayErr(p, "TriggerSchedule", namespace, scheduleID)
+}
+
+func (p *Player) DeleteSchedule(ctx context.Context, namespace, scheduleID string) error {
+	return replayErr(p, "DeleteSchedule", namespace, scheduleID)
+}
+
+func (p *Player) CreateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	return replayErr(p, "CreateSchedule", namespace, def)
+}
+
+func (p *Player) UpdateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	return replayErr(p, "UpdateSchedule", namespace, def)
+}
+
+// Query Operations
+
+func (p *Player) QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*temporal.QueryResult, error) {
+	return replay[*temporal.QueryResult](p, "QueryWorkflow", namespace, workflowID, runID, queryType, args)
+}
+
+// Batch Operations
+
+func (p *Player) CancelWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier) ([]temporal.BatchResult, error) {
+	return replay[[]temporal.BatchResult](p, "CancelWorkflows", namespace, workflows)
+}
+
+func (p *Player) TerminateWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier, reason string) ([]temporal.BatchResult, error) {
+	return replay[[]temporal.BatchResult](p, "TerminateWorkflows", namespace, workflows, reason)
+}
+
+func (p *Player) GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]temporal.ResetPoint, error) {
+	return replay[[]temporal.ResetPoint](p, "GetResetPoints", namespace, workflowID, runID)
+}
+
+func (p *Player) CountWorkflows(ctx context.Context, namespace, query, groupBy string) (int64, []temporal.WorkflowCountGroup, error) {
+	res, err := replay[countResult](p, "CountWorkflows", namespace, query, groupBy)
+	return res.Count, res.Groups, err
+}
+
+func (p *Player) StartBatchOperation(ctx context.Context, namespace string, req temporal.BatchOperationRequest) (string, error) {
+	return replay[string](p, "StartBatchOperation", namespace, req)
+}
+
+func (p *Player) ListBatchOperations(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.BatchOperation, string, error) {
+	page, err := replay[pageResult[temporal.BatchOperation]](p, "ListBatchOperations", namespace, opts)
+	return page.Items, page.NextPageToken, err
+}
+
+func (p *Player) DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*temporal.BatchOperation, error) {
+	return replay[*temporal.BatchOperation](p, "DescribeBatchOperation", namespace, jobID)
+}
+
+func (p *Player) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	return replayErr(p, "StopBatchOperation", namespace, jobID, reason)
+}
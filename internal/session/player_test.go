@@ -0,0 +1,102 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// writeSession writes entries built from (method, args, result) triples to a session file.
+func writeSession(t *testing.T, calls ...[3]any) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for _, c := range calls {
+		args, err := encodeArgs(c[1].([]any)...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := json.Marshal(c[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Encode(Entry{Method: c[0].(string), Args: args, Result: result}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return path
+}
+
+func TestPlayerListFallback(t *testing.T) {
+	recorded := temporal.ListOptions{PageSize: 50, Query: "StartTime > '2026-10-15T00:00:00+02:00'"}
+	firstPage := pageResult[temporal.Workflow]{Items: []temporal.Workflow{{ID: "wf-1"}}, NextPageToken: "page-2"}
+	p, err := NewPlayer(writeSession(t, [3]any{"ListWorkflows", []any{"default", recorded}, firstPage}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	// A placeholder resolved on another day still replays the recorded page, every time
+	later := recorded
+	later.Query = "StartTime > '2026-10-16T00:00:00Z'"
+	for range 2 {
+		items, token, err := p.ListWorkflows(ctx, "default", later)
+		if err != nil || len(items) != 1 || token != "page-2" {
+			t.Fatalf("ListWorkflows(%q) = %v, %q, %v; want the recorded page", later.Query, items, token, err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		opts temporal.ListOptions
+	}{
+		{"another page", temporal.ListOptions{PageSize: 50, PageToken: "page-2", Query: recorded.Query}},
+		{"another query", temporal.ListOptions{PageSize: 50, Query: "StartTime > '2026-10-15T00:00:00Z' AND TaskQueue = 'orders'"}},
+		{"another page size", temporal.ListOptions{PageSize: 100, Query: recorded.Query}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := p.ListWorkflows(ctx, "default", tt.opts); err == nil {
+				t.Errorf("ListWorkflows(%+v) replayed a call that wasn't recorded", tt.opts)
+			}
+		})
+	}
+}
+
+func TestPlayerEndOfRecording(t *testing.T) {
+	page := &temporal.HistoryPage{Events: []temporal.EnhancedHistoryEvent{{ID: 1}}, NextPageToken: []byte("more")}
+	p, err := NewPlayer(writeSession(t,
+		[3]any{"GetWorkflow", []any{"default", "wf-1", "run-1"}, &temporal.Workflow{ID: "wf-1"}},
+		[3]any{"FollowWorkflowHistory", []any{"default", "wf-1", "run-1", []byte(nil)}, page},
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.GetWorkflow(context.Background(), "default", "wf-1", "run-1"); err != nil {
+		t.Fatalf("GetWorkflow: %v", err)
+	}
+	if _, err := p.GetWorkflow(context.Background(), "default", "wf-1", "run-1"); !errors.Is(err, ErrEndOfRecording) {
+		t.Errorf("second GetWorkflow error = %v, want ErrEndOfRecording", err)
+	}
+
+	if _, err := p.FollowWorkflowHistory(context.Background(), "default", "wf-1", "run-1", nil); err != nil {
+		t.Fatalf("FollowWorkflowHistory: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := p.FollowWorkflowHistory(ctx, "default", "wf-1", "run-1", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("exhausted FollowWorkflowHistory error = %v, want it to wait for the context", err)
+	}
+}
@@ -0,0 +1,418 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// Recorder wraps a Provider and writes every call and its result to a session file.
+// Each Provider method delegates to the wrapped provider and records the outcome.
+type Recorder struct {
+	provider temporal.Provider
+	mu       sync.Mutex
+	file     *os.File
+	enc      *json.Encoder
+}
+
+// Ensure Recorder implements temporal.Provider.
+var _ temporal.Provider = (*Recorder)(nil)
+
+// NewRecorder creates a recorder that writes to path, truncating any existing file.
+func NewRecorder(provider temporal.Provider, path string) (*Recorder, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session file: %w", err)
+	}
+
+	r := &Recorder{
+		provider: provider,
+		file:     f,
+		enc:      json.NewEncoder(f),
+	}
+	// Record the connection config first so replay can report it.
+	r.write("Config", redactConfig(provider.Config()), nil)
+	return r, nil
+}
+
+// redactedValue stands in for credentials in recorded configs.
+const redactedValue = "[redacted]"
+
+// redactConfig returns a copy of config that is safe to share in a session file.
+// API keys, key commands and header values are replaced, so the file shows that
+// auth was configured without revealing it.
+func redactConfig(config temporal.ConnectionConfig) temporal.ConnectionConfig {
+	if config.APIKey != "" {
+		config.APIKey = redactedValue
+	}
+	if config.APIKeyCommand != "" {
+		config.APIKeyCommand = redactedValue
+	}
+	if len(config.Headers) > 0 {
+		headers := make(map[string]string, len(config.Headers))
+		for name := range config.Headers {
+			headers[name] = redactedValue
+		}
+		config.Headers = headers
+	}
+	return config
+}
+
+// write appends an entry to the session file.
+// Recording is best-effort: a failed write never fails the underlying call.
+func (r *Recorder) write(method string, result any, callErr error, args ...any) {
+	encArgs, err := encodeArgs(args...)
+	if err != nil {
+		return
+	}
+	e := Entry{
+		Method: method,
+		Args:   encArgs,
+		Time:   time.Now(),
+	}
+	if callErr != nil {
+		e.Error = callErr.Error()
+	} else if result != nil {
+		if e.Result, err = json.Marshal(result); err != nil {
+			return
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.enc != nil {
+		_ = r.enc.Encode(e)
+	}
+}
+
+// record writes an entry for a call returning a single value and passes the result through.
+func record[T any](r *Recorder, method string, result T, err error, args ...any) (T, error) {
+	r.write(method, result, err, args...)
+	return result, err
+}
+
+// Connection Management
+
+// Close closes the wrapped provider and the session file.
+func (r *Recorder) Close() error {
+	err := r.provider.Close()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.file != nil {
+		if cerr := r.file.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+		r.file = nil
+		r.enc = nil
+	}
+	return err
+}
+
+// IsConnected returns whether the wrapped provider is connected.
+func (r *Recorder) IsConnected() bool {
+	return r.provider.IsConnected()
+}
+
+// CheckConnection checks the wrapped provider's connection.
+func (r *Recorder) CheckConnection(ctx context.Context) error {
+	return r.provider.CheckConnection(ctx)
+}
+
+// Reconnect reconnects the wrapped provider.
+func (r *Recorder) Reconnect(ctx context.Context) error {
+	return r.provider.Reconnect(ctx)
+}
+
+// ReconnectWithConfig reconnects the wrapped provider and records the new config.
+func (r *Recorder) ReconnectWithConfig(ctx context.Context, config temporal.ConnectionConfig) error {
+	err := r.provider.ReconnectWithConfig(ctx, config)
+	if err == nil {
+		r.write("Config", redactConfig(r.provider.Config()), nil)
+	}
+	return err
+}
+
+// Config returns the wrapped provider's connection config.
+func (r *Recorder) Config() temporal.ConnectionConfig {
+	return r.provider.Config()
+}
+
+// Namespaces
+
+func (r *Recorder) ListNamespaces(ctx context.Context) ([]temporal.Namespace, error) {
+	result, err := r.provider.ListNamespaces(ctx)
+	return record(r, "ListNamespaces", result, err)
+}
+
+func (r *Recorder) CreateNamespace(ctx context.Context, req temporal.NamespaceCreateRequest) error {
+	err := r.provider.CreateNamespace(ctx, req)
+	r.write("CreateNamespace", nil, err, req)
+	return err
+}
+
+func (r *Recorder) DescribeNamespace(ctx context.Context, name string) (*temporal.NamespaceDetail, error) {
+	result, err := r.provider.DescribeNamespace(ctx, name)
+	return record(r, "DescribeNamespace", result, err, name)
+}
+
+func (r *Recorder) UpdateNamespace(ctx context.Context, req temporal.NamespaceUpdateRequest) error {
+	err := r.provider.UpdateNamespace(ctx, req)
+	r.write("UpdateNamespace", nil, err, req)
+	return err
+}
+
+func (r *Recorder) DeprecateNamespace(ctx context.Context, name string) error {
+	err := r.provider.DeprecateNamespace(ctx, name)
+	r.write("DeprecateNamespace", nil, err, name)
+	return err
+}
+
+func (r *Recorder) DeleteNamespace(ctx context.Context, name string) error {
+	err := r.provider.DeleteNamespace(ctx, name)
+	r.write("DeleteNamespace", nil, err, name)
+	return err
+}
+
+func (r *Recorder) ListSearchAttributes(ctx context.Context, namespace string) ([]temporal.SearchAttributeInfo, error) {
+	result, err := r.provider.ListSearchAttributes(ctx, namespace)
+	return record(r, "ListSearchAttributes", result, err, namespace)
+}
+
+func (r *Recorder) AddSearchAttributes(ctx context.Context, namespace string, attributes map[string]string) error {
+	err := r.provider.AddSearchAttributes(ctx, namespace, attributes)
+	r.write("AddSearchAttributes", nil, err, namespace, attributes)
+	return err
+}
+
+func (r *Recorder) RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error {
+	err := r.provider.RemoveSearchAttributes(ctx, namespace, names)
+	r.write("RemoveSearchAttributes", nil, err, namespace, names)
+	return err
+}
+
+// Workflows
+
+func (r *Recorder) ListWorkflows(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Workflow, string, error) {
+	items, token, err := r.provider.ListWorkflows(ctx, namespace, opts)
+	r.write("ListWorkflows", pageResult[temporal.Workflow]{Items: items, NextPageToken: token}, err, namespace, opts)
+	return items, token, err
+}
+
+func (r *Recorder) GetWorkflow(ctx context.Context, namespace, workflowID, runID string) (*temporal.Workflow, error) {
+	result, err := r.provider.GetWorkflow(ctx, namespace, workflowID, runID)
+	return record(r, "GetWorkflow", result, err, namespace, workflowID, runID)
+}
+
+func (r *Recorder) GetWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.HistoryEvent, error) {
+	result, err := r.provider.GetWorkflowHistory(ctx, namespace, workflowID, runID)
+	return record(r, "GetWorkflowHistory", result, err, namespace, workflowID, runID)
+}
+
+func (r *Recorder) GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]temporal.EnhancedHistoryEvent, error) {
+	result, err := r.provider.GetEnhancedWorkflowHistory(ctx, namespace, workflowID, runID)
+	return record(r, "GetEnhancedWorkflowHistory", result, err, namespace, workflowID, runID)
+}
+
+func (r *Recorder) GetWorkflowHistoryPage(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	result, err := r.provider.GetWorkflowHistoryPage(ctx, namespace, workflowID, runID, pageToken)
+	return record(r, "GetWorkflowHistoryPage", result, err, namespace, workflowID, runID, pageToken)
+}
+
+func (r *Recorder) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	result, err := r.provider.ExportWorkflowHistory(ctx, namespace, workflowID, runID)
+	return record(r, "ExportWorkflowHistory", result, err, namespace, workflowID, runID)
+}
+
+func (r *Recorder) FollowWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*temporal.HistoryPage, error) {
+	result, err := r.provider.FollowWorkflowHistory(ctx, namespace, workflowID, runID, pageToken)
+	return record(r, "FollowWorkflowHistory", result, err, namespace, workflowID, runID, pageToken)
+}
+
+func (r *Recorder) DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*temporal.TaskQueueInfo, []temporal.Poller, error) {
+	info, pollers, err := r.provider.DescribeTaskQueue(ctx, namespace, taskQueue)
+	r.write("DescribeTaskQueue", taskQueueResult[*temporal.TaskQueueInfo, temporal.Poller]{Info: info, Pollers: pollers}, err, namespace, taskQueue)
+	return info, pollers, err
+}
+
+// Workflow Mutations
+
+func (r *Recorder) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	err := r.provider.CancelWorkflow(ctx, namespace, workflowID, runID, reason)
+	r.write("CancelWorkflow", nil, err, namespace, workflowID, runID, reason)
+	return err
+}
+
+func (r *Recorder) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
+	err := r.provider.TerminateWorkflow(ctx, namespace, workflowID, runID, reason)
+	r.write("TerminateWorkflow", nil, err, namespace, workflowID, runID, reason)
+	return err
+}
+
+func (r *Recorder) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
+	err := r.provider.SignalWorkflow(ctx, namespace, workflowID, runID, signalName, input)
+	r.write("SignalWorkflow", nil, err, namespace, workflowID, runID, signalName, input)
+	return err
+}
+
+func (r *Recorder) StartWorkflow(ctx context.Context, namespace string, req temporal.StartWorkflowRequest) (string, error) {
+	result, err := r.provider.StartWorkflow(ctx, namespace, req)
+	return record(r, "StartWorkflow", result, err, namespace, req)
+}
+
+func (r *Recorder) SignalWithStartWorkflow(ctx context.Context, namespace string, req temporal.SignalWithStartRequest) (string, error) {
+	result, err := r.provider.SignalWithStartWorkflow(ctx, namespace, req)
+	return record(r, "SignalWithStartWorkflow", result, err, namespace, req)
+}
+
+func (r *Recorder) UpdateWorkflow(ctx context.Context, namespace string, req temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	result, err := r.provider.UpdateWorkflow(ctx, namespace, req)
+	return record(r, "UpdateWorkflow", result, err, namespace, req)
+}
+
+func (r *Recorder) UpdateWithStartWorkflow(ctx context.Context, namespace string, start temporal.StartWorkflowRequest, update temporal.UpdateWorkflowRequest) (*temporal.UpdateResult, error) {
+	result, err := r.provider.UpdateWithStartWorkflow(ctx, namespace, start, update)
+	return record(r, "UpdateWithStartWorkflow", result, err, namespace, start, update)
+}
+
+func (r *Recorder) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
+	err := r.provider.DeleteWorkflow(ctx, namespace, workflowID, runID)
+	r.write("DeleteWorkflow", nil, err, namespace, workflowID, runID)
+	return err
+}
+
+func (r *Recorder) ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error) {
+	result, err := r.provider.ResetWorkflow(ctx, namespace, workflowID, runID, eventID, reason)
+	return record(r, "ResetWorkflow", result, err, namespace, workflowID, runID, eventID, reason)
+}
+
+// Activities
+
+func (r *Recorder) PauseActivity(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	err := r.provider.PauseActivity(ctx, namespace, workflowID, runID, activityID, reason)
+	r.write("PauseActivity", nil, err, namespace, workflowID, runID, activityID, reason)
+	return err
+}
+
+func (r *Recorder) UnpauseActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetAttempts bool) error {
+	err := r.provider.UnpauseActivity(ctx, namespace, workflowID, runID, activityID, resetAttempts)
+	r.write("UnpauseActivity", nil, err, namespace, workflowID, runID, activityID, resetAttempts)
+	return err
+}
+
+func (r *Recorder) ResetActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetHeartbeat, keepPaused bool) error {
+	err := r.provider.ResetActivity(ctx, namespace, workflowID, runID, activityID, resetHeartbeat, keepPaused)
+	r.write("ResetActivity", nil, err, namespace, workflowID, runID, activityID, resetHeartbeat, keepPaused)
+	return err
+}
+
+func (r *Recorder) UpdateActivityOptions(ctx context.Context, namespace, workflowID, runID, activityID string, opts temporal.ActivityOptions) (*temporal.ActivityOptions, error) {
+	result, err := r.provider.UpdateActivityOptions(ctx, namespace, workflowID, runID, activityID, opts)
+	return record(r, "UpdateActivityOptions", result, err, namespace, workflowID, runID, activityID, opts)
+}
+
+// Schedules
+
+func (r *Recorder) ListSchedules(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.Schedule, string, error) {
+	items, token, err := r.provider.ListSchedules(ctx, namespace, opts)
+	r.write("ListSchedules", pageResult[temporal.Schedule]{Items: items, NextPageToken: token}, err, namespace, opts)
+	return items, token, err
+}
+
+func (r *Recorder) GetSchedule(ctx context.Context, namespace, scheduleID string) (*temporal.Schedule, error) {
+	result, err := r.provider.GetSchedule(ctx, namespace, scheduleID)
+	return record(r, "GetSchedule", result, err, namespace, scheduleID)
+}
+
+func (r *Recorder) PauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	err := r.provider.PauseSchedule(ctx, namespace, scheduleID, reason)
+	r.write("PauseSchedule", nil, err, namespace, scheduleID, reason)
+	return err
+}
+
+func (r *Recorder) UnpauseSchedule(ctx context.Context, namespace, scheduleID, reason string) error {
+	err := r.provider.UnpauseSchedule(ctx, namespace, scheduleID, reason)
+	r.write("UnpauseSchedule", nil, err, namespace, scheduleID, reason)
+	return err
+}
+
+func (r *Recorder) TriggerSchedule(ctx context.Context, namespace, scheduleID string) error {
+	err := r.provider.TriggerSchedule(ctx, namespace, scheduleID)
+	r.write("TriggerSchedule", nil, err, namespace, scheduleID)
+	return err
+}
+
+func (r *Recorder) DeleteSchedule(ctx context.Context, namespace, scheduleID string) error {
+	err := r.provider.DeleteSchedule(ctx, namespace, scheduleID)
+	r.write("DeleteSchedule", nil, err, namespace, scheduleID)
+	return err
+}
+
+func (r *Recorder) CreateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	err := r.provider.CreateSchedule(ctx, namespace, def)
+	r.write("CreateSchedule", nil, err, namespace, def)
+	return err
+}
+
+func (r *Recorder) UpdateSchedule(ctx context.Context, namespace string, def temporal.ScheduleDefinition) error {
+	err := r.provider.UpdateSchedule(ctx, namespace, def)
+	r.write("UpdateSchedule", nil, err, namespace, def)
+	return err
+}
+
+// Query Operations
+
+func (r *Recorder) QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*temporal.QueryResult, error) {
+	result, err := r.provider.QueryWorkflow(ctx, namespace, workflowID, runID, queryType, args)
+	return record(r, "QueryWorkflow", result, err, namespace, workflowID, runID, queryType, args)
+}
+
+// Batch Operations
+
+func (r *Recorder) CancelWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier) ([]temporal.BatchResult, error) {
+	result, err := r.provider.CancelWorkflows(ctx, namespace, workflows)
+	return record(r, "CancelWorkflows", result, err, namespace, workflows)
+}
+
+func (r *Recorder) TerminateWorkflows(ctx context.Context, namespace string, workflows []temporal.WorkflowIdentifier, reason string) ([]temporal.BatchResult, error) {
+	result, err := r.provider.TerminateWorkflows(ctx, namespace, workflows, reason)
+	return record(r, "TerminateWorkflows", result, err, namespace, workflows, reason)
+}
+
+func (r *Recorder) GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]temporal.ResetPoint, error) {
+	result, err := r.provider.GetResetPoints(ctx, namespace, workflowID, runID)
+	return record(r, "GetResetPoints", result, err, namespace, workflowID, runID)
+}
+
+func (r *Recorder) CountWorkflows(ctx context.Context, namespace, query, groupBy string) (int64, []temporal.WorkflowCountGroup, error) {
+	count, groups, err := r.provider.CountWorkflows(ctx, namespace, query, groupBy)
+	r.write("CountWorkflows", countResult{Count: count, Groups: groups}, err, namespace, query, groupBy)
+	return count, groups, err
+}
+
+func (r *Recorder) StartBatchOperation(ctx context.Context, namespace string, req temporal.BatchOperationRequest) (string, error) {
+	result, err := r.provider.StartBatchOperation(ctx, namespace, req)
+	return record(r, "StartBatchOperation", result, err, namespace, req)
+}
+
+func (r *Recorder) ListBatchOperations(ctx context.Context, namespace string, opts temporal.ListOptions) ([]temporal.BatchOperation, string, error) {
+	items, token, err := r.provider.ListBatchOperations(ctx, namespace, opts)
+	r.write("ListBatchOperations", pageResult[temporal.BatchOperation]{Items: items, NextPageToken: token}, err, namespace, opts)
+	return items, token, err
+}
+
+func (r *Recorder) DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*temporal.BatchOperation, error) {
+	result, err := r.provider.DescribeBatchOperation(ctx, namespace, jobID)
+	return record(r, "DescribeBatchOperation", result, err, namespace, jobID)
+}
+
+func (r *Recorder) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	err := r.provider.StopBatchOperation(ctx, namespace, jobID, reason)
+	r.write("StopBatchOperation", nil, err, namespace, jobID, reason)
+	return err
+}
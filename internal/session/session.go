@@ -0,0 +1,53 @@
+// Package session records Provider calls to a file and replays them offline.
+//
+// A session file is JSON Lines: one Entry per Provider call, in call order.
+// Calls are keyed by method name and arguments (the context is not recorded),
+// so replaying the same navigation produces the same results without a server.
+package session
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// Entry is a single recorded Provider call.
+type Entry struct {
+	Method string          `json:"method"`
+	Args   json.RawMessage `json:"args"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  string          `json:"error,omitempty"`
+	Time   time.Time       `json:"time"`
+}
+
+// key identifies a call by method and canonical argument encoding.
+func key(method string, args json.RawMessage) string {
+	return method + " " + string(args)
+}
+
+// encodeArgs encodes call arguments positionally.
+func encodeArgs(args ...any) (json.RawMessage, error) {
+	if args == nil {
+		args = []any{}
+	}
+	return json.Marshal(args)
+}
+
+// pageResult holds the results of paginated list calls.
+type pageResult[T any] struct {
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+// countResult holds the results of CountWorkflows.
+type countResult struct {
+	Count  int64                         `json:"count"`
+	Groups []temporal.WorkflowCountGroup `json:"groups,omitempty"`
+}
+
+// taskQueueResult holds the results of DescribeTaskQueue.
+type taskQueueResult[I, P any] struct {
+	Info    I   `json:"info"`
+	Pollers []P `json:"pollers"`
+}
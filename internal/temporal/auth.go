@@ -0,0 +1,74 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// apiKeyCommandTimeout bounds how long an API key command may run.
+const apiKeyCommandTimeout = 10 * time.Second
+
+// resolveAPIKey returns the API key for a connection, taken from the literal key,
+// the named environment variable, or the stdout of a command, in that order.
+// An empty key means API key authentication is not configured.
+func resolveAPIKey(config ConnectionConfig) (string, error) {
+	if config.APIKey != "" {
+		return config.APIKey, nil
+	}
+
+	if config.APIKeyEnv != "" {
+		key := strings.TrimSpace(os.Getenv(config.APIKeyEnv))
+		if key == "" {
+			return "", fmt.Errorf("environment variable %s is not set", config.APIKeyEnv)
+		}
+		return key, nil
+	}
+
+	if config.APIKeyCommand != "" {
+		return runAPIKeyCommand(config.APIKeyCommand)
+	}
+
+	return "", nil
+}
+
+// runAPIKeyCommand runs a shell command and returns its trimmed stdout.
+func runAPIKeyCommand(command string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), apiKeyCommandTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("API key command failed: %w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("API key command failed: %w", err)
+	}
+
+	key := strings.TrimSpace(string(out))
+	if key == "" {
+		return "", fmt.Errorf("API key command returned no output")
+	}
+	return key, nil
+}
+
+// staticHeaders sends a fixed set of gRPC metadata with every request.
+type staticHeaders map[string]string
+
+// GetHeaders implements client.HeadersProvider.
+func (h staticHeaders) GetHeaders(context.Context) (map[string]string, error) {
+	return h, nil
+}
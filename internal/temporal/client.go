@@ -1,6 +1,7 @@
 package temporal
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
@@ -14,15 +15,27 @@ import (
 	"time"
 
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/google/uuid"
+	batchpb "go.temporal.io/api/batch/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
 	historypb "go.temporal.io/api/history/v1"
 	namespacepb "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
+	querypb "go.temporal.io/api/query/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/temporalproto"
+	updatepb "go.temporal.io/api/update/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -30,6 +43,9 @@ var (
 	sdkLogger *fileLogger
 )
 
+// historyPageSize is the number of events requested per history page.
+const historyPageSize = 1000
+
 // fileLogger writes logs to a file.
 type fileLogger struct {
 	logger *log.Logger
@@ -74,6 +90,8 @@ func initLogFile() {
 type Client struct {
 	client    client.Client
 	config    ConnectionConfig
+	codec     *remoteCodec  // nil without a codec endpoint
+	protos    *protoDecoder // nil without proto descriptors
 	connected bool
 	mu        sync.RWMutex
 }
@@ -83,31 +101,92 @@ func NewClient(ctx context.Context, connConfig ConnectionConfig) (*Client, error
 	// Redirect logs to file instead of stdout
 	initLogFile()
 
+	codec, protos, err := payloadDecoders(connConfig)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := buildClientOptions(connConfig, codec, protos)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := client.DialContext(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to Temporal server: %w", err)
+	}
+
+	return &Client{
+		client:    c,
+		config:    connConfig,
+		codec:     codec,
+		protos:    protos,
+		connected: true,
+	}, nil
+}
+
+// payloadDecoders loads the remote codec and protobuf descriptors configured for a
+// connection. Either is nil when not configured.
+func payloadDecoders(connConfig ConnectionConfig) (*remoteCodec, *protoDecoder, error) {
+	protos, err := newProtoDecoder(connConfig.ProtoDescriptorPaths)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load proto descriptors: %w", err)
+	}
+	return newRemoteCodec(connConfig.CodecEndpoint), protos, nil
+}
+
+// buildClientOptions creates SDK client options from the connection config.
+func buildClientOptions(connConfig ConnectionConfig, codec *remoteCodec, protos *protoDecoder) (client.Options, error) {
 	opts := client.Options{
 		HostPort:  connConfig.Address,
 		Namespace: connConfig.Namespace,
 		Logger:    sdkLogger,
+		Identity:  clientIdentity(),
 	}
 
-	// Configure TLS if any TLS options are provided
-	if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
+	apiKey, err := resolveAPIKey(connConfig)
+	if err != nil {
+		return opts, fmt.Errorf("failed to resolve API key: %w", err)
+	}
+	if apiKey != "" {
+		opts.Credentials = client.NewAPIKeyStaticCredentials(apiKey)
+	}
+
+	if len(connConfig.Headers) > 0 {
+		opts.HeadersProvider = staticHeaders(connConfig.Headers)
+	}
+
+	// Configure TLS if enabled or any TLS options are provided. API keys are only
+	// accepted over TLS, so an API key enables it even without certificates.
+	if connConfig.TLSEnabled || connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify || apiKey != "" {
 		tlsConfig, err := buildTLSConfig(connConfig)
 		if err != nil {
-			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+			return opts, fmt.Errorf("failed to configure TLS: %w", err)
 		}
 		opts.ConnectionOptions.TLS = tlsConfig
 	}
 
-	c, err := client.DialContext(ctx, opts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to Temporal server: %w", err)
+	// Decode payloads before they reach any view. Interceptors see responses in
+	// reverse order, so the codec runs first and protobuf rendering sees its output.
+	var interceptors []grpc.UnaryClientInterceptor
+	if protos != nil {
+		interceptors = append(interceptors, protos.interceptor())
+	}
+	if codec != nil {
+		interceptors = append(interceptors, codec.interceptor())
+	}
+	if len(interceptors) > 0 {
+		opts.ConnectionOptions.DialOptions = append(opts.ConnectionOptions.DialOptions,
+			grpc.WithChainUnaryInterceptor(interceptors...))
 	}
 
-	return &Client{
-		client:    c,
-		config:    connConfig,
-		connected: true,
-	}, nil
+	return opts, nil
+}
+
+// clientIdentity returns the identity recorded in history for requests made by this
+// client, in the same "pid@host@" form the SDK uses by default.
+func clientIdentity() string {
+	host, _ := os.Hostname()
+	return fmt.Sprintf("%d@%s@", os.Getpid(), host)
 }
 
 // buildTLSConfig creates a TLS configuration from the connection config.
@@ -219,19 +298,13 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 	c.connected = false
 	c.mu.Unlock()
 
-	opts := client.Options{
-		HostPort:  connConfig.Address,
-		Namespace: connConfig.Namespace,
-		Logger:    sdkLogger,
+	codec, protos, err := payloadDecoders(connConfig)
+	if err != nil {
+		return err
 	}
-
-	// Configure TLS if any TLS options are provided
-	if connConfig.TLSCertPath != "" || connConfig.TLSCAPath != "" || connConfig.TLSSkipVerify {
-		tlsConfig, err := buildTLSConfig(connConfig)
-		if err != nil {
-			return fmt.Errorf("failed to configure TLS: %w", err)
-		}
-		opts.ConnectionOptions.TLS = tlsConfig
+	opts, err := buildClientOptions(connConfig, codec, protos)
+	if err != nil {
+		return err
 	}
 
 	newClient, err := client.DialContext(ctx, opts)
@@ -242,6 +315,8 @@ func (c *Client) reconnectWithConfig(ctx context.Context, connConfig ConnectionC
 	c.mu.Lock()
 	c.client = newClient
 	c.config = connConfig // Update stored config
+	c.codec = codec
+	c.protos = protos
 	c.connected = true
 	c.mu.Unlock()
 
@@ -438,6 +513,62 @@ func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
 	return nil
 }
 
+// ListSearchAttributes returns the system and custom search attributes of a namespace.
+func (c *Client) ListSearchAttributes(ctx context.Context, namespace string) ([]SearchAttributeInfo, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list search attributes: %w", err)
+	}
+	return newSearchAttributeInfos(resp.GetSystemAttributes(), resp.GetCustomAttributes()), nil
+}
+
+// AddSearchAttributes registers custom search attributes on a namespace.
+func (c *Client) AddSearchAttributes(ctx context.Context, namespace string, attributes map[string]string) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	types := make(map[string]enums.IndexedValueType, len(attributes))
+	for name, typ := range attributes {
+		t, err := parseSearchAttributeType(typ)
+		if err != nil {
+			return err
+		}
+		types[name] = t
+	}
+
+	_, err := c.client.OperatorService().AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: types,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add search attributes: %w", err)
+	}
+	return nil
+}
+
+// RemoveSearchAttributes removes custom search attributes from a namespace.
+func (c *Client) RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+
+	_, err := c.client.OperatorService().RemoveSearchAttributes(ctx, &operatorservice.RemoveSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: names,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove search attributes: %w", err)
+	}
+	return nil
+}
+
 // formatArchivalState formats archival state and URI for display.
 func formatArchivalState(state enums.ArchivalState, uri string) string {
 	stateStr := "Disabled"
@@ -502,21 +633,8 @@ func (c *Client) ListWorkflows(ctx context.Context, namespace string, opts ListO
 			wf.ParentID = &parentID
 		}
 
-		// Extract memo if present
-		if exec.GetMemo() != nil && exec.GetMemo().GetFields() != nil {
-			wf.Memo = make(map[string]string)
-			for k, v := range exec.GetMemo().GetFields() {
-				// Try to extract string value from payload
-				if v != nil && v.GetData() != nil {
-					var strVal string
-					if err := json.Unmarshal(v.GetData(), &strVal); err == nil {
-						wf.Memo[k] = strVal
-					} else {
-						wf.Memo[k] = string(v.GetData())
-					}
-				}
-			}
-		}
+		wf.Memo = decodeMemo(exec.GetMemo())
+		wf.SearchAttributes = decodeSearchAttributes(exec.GetSearchAttributes())
 
 		workflows = append(workflows, wf)
 	}
@@ -550,6 +668,12 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 		Namespace: namespace,
 		TaskQueue: info.GetTaskQueue(),
 		StartTime: info.GetStartTime().AsTime(),
+
+		HistoryLength:    info.GetHistoryLength(),
+		HistorySizeBytes: info.GetHistorySizeBytes(),
+
+		Memo:             decodeMemo(info.GetMemo()),
+		SearchAttributes: decodeSearchAttributes(info.GetSearchAttributes()),
 	}
 
 	if info.GetCloseTime() != nil && !info.GetCloseTime().AsTime().IsZero() {
@@ -560,30 +684,88 @@ func (c *Client) GetWorkflow(ctx context.Context, namespace, workflowID, runID s
 	if info.GetParentExecution() != nil && info.GetParentExecution().GetWorkflowId() != "" {
 		parentID := info.GetParentExecution().GetWorkflowId()
 		wf.ParentID = &parentID
+		wf.ParentRunID = info.GetParentExecution().GetRunId()
 	}
 
-	// Fetch input/output from workflow history
-	wf.Input, wf.Output = c.getWorkflowInputOutput(ctx, namespace, workflowID, runID)
+	applyPendingInfo(wf, resp)
+
+	// Fetch input/output and links to other runs from workflow history
+	events := c.getWorkflowSummaryEvents(ctx, namespace, wf.ID, wf.RunID, wf.EndTime != nil)
+	wf.Input, wf.Output = workflowInputOutput(events)
+	wf.FirstRunID, wf.PrevRunID, wf.NextRunID = workflowRunLinks(events)
+	if info.GetFirstRunId() != "" {
+		wf.FirstRunID = info.GetFirstRunId()
+	}
 
 	return wf, nil
 }
 
-// getWorkflowInputOutput extracts input and output from workflow history events.
-func (c *Client) getWorkflowInputOutput(ctx context.Context, namespace, workflowID, runID string) (input, output string) {
-	// Get workflow history to extract input/output
+// getWorkflowSummaryEvents returns the first page of a workflow's history, plus the
+// close event when a closed workflow's history runs past that page.
+func (c *Client) getWorkflowSummaryEvents(ctx context.Context, namespace, workflowID, runID string, closed bool) []*historypb.HistoryEvent {
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: workflowID,
+		RunId:      runID,
+	}
 	histResp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
-		Namespace: namespace,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: workflowID,
-			RunId:      runID,
-		},
+		Namespace:       namespace,
+		Execution:       execution,
 		MaximumPageSize: 100, // Usually enough to get start and end events
 	})
 	if err != nil {
-		return "", ""
+		return nil
 	}
-
 	events := histResp.GetHistory().GetEvents()
+
+	if closed && len(histResp.GetNextPageToken()) > 0 {
+		closeResp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace:              namespace,
+			Execution:              execution,
+			HistoryEventFilterType: enums.HISTORY_EVENT_FILTER_TYPE_CLOSE_EVENT,
+		})
+		if err == nil {
+			events = append(events, closeResp.GetHistory().GetEvents()...)
+		}
+	}
+	return events
+}
+
+// workflowRunLinks returns the first, previous and next runs recorded in a run's history.
+func workflowRunLinks(events []*historypb.HistoryEvent) (firstRunID, prevRunID, nextRunID string) {
+	for _, event := range events {
+		switch event.GetEventType() {
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
+			attrs := event.GetWorkflowExecutionStartedEventAttributes()
+			firstRunID = attrs.GetFirstExecutionRunId()
+			prevRunID = attrs.GetContinuedExecutionRunId()
+
+		case enums.EVENT_TYPE_WORKFLOW_TASK_FAILED:
+			// A reset run records the run it was reset from; the latest reset wins
+			attrs := event.GetWorkflowTaskFailedEventAttributes()
+			if attrs.GetCause() == enums.WORKFLOW_TASK_FAILED_CAUSE_RESET_WORKFLOW && attrs.GetBaseRunId() != "" {
+				prevRunID = attrs.GetBaseRunId()
+			}
+
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW:
+			nextRunID = event.GetWorkflowExecutionContinuedAsNewEventAttributes().GetNewExecutionRunId()
+
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:
+			// Cron workflows start the next run on completion
+			nextRunID = event.GetWorkflowExecutionCompletedEventAttributes().GetNewExecutionRunId()
+
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:
+			// Retries start the next run on failure or timeout
+			nextRunID = event.GetWorkflowExecutionFailedEventAttributes().GetNewExecutionRunId()
+
+		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:
+			nextRunID = event.GetWorkflowExecutionTimedOutEventAttributes().GetNewExecutionRunId()
+		}
+	}
+	return firstRunID, prevRunID, nextRunID
+}
+
+// workflowInputOutput formats the workflow input and result or failure from its history events.
+func workflowInputOutput(events []*historypb.HistoryEvent) (input, output string) {
 	for _, event := range events {
 		switch event.GetEventType() {
 		case enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED:
@@ -677,6 +859,55 @@ func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, work
 	var events []EnhancedHistoryEvent
 	var nextPageToken []byte
 
+	for {
+		page, err := c.GetWorkflowHistoryPage(ctx, namespace, workflowID, runID, nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, page.Events...)
+
+		nextPageToken = page.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+// GetWorkflowHistoryPage returns one page of history events.
+func (c *Client) GetWorkflowHistoryPage(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*HistoryPage, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: historyPageSize,
+		NextPageToken:   pageToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow history: %w", err)
+	}
+
+	return newHistoryPage(resp), nil
+}
+
+// ExportWorkflowHistory returns the raw history as protojson for the SDK replayer.
+func (c *Client) ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	// The replayer decodes payloads with the worker's own data converter
+	ctx = withRawPayloads(ctx)
+
+	history := &historypb.History{}
+	var nextPageToken []byte
 	for {
 		resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
 			Namespace: namespace,
@@ -684,24 +915,60 @@ func (c *Client) GetEnhancedWorkflowHistory(ctx context.Context, namespace, work
 				WorkflowId: workflowID,
 				RunId:      runID,
 			},
-			NextPageToken: nextPageToken,
+			MaximumPageSize: historyPageSize,
+			NextPageToken:   nextPageToken,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to get workflow history: %w", err)
 		}
+		history.Events = append(history.Events, resp.GetHistory().GetEvents()...)
 
-		for _, event := range resp.GetHistory().GetEvents() {
-			he := extractEnhancedEvent(event)
-			events = append(events, he)
-		}
-
-		nextPageToken = resp.GetNextPageToken()
+		nextPageToken = resp.NextPageToken
 		if len(nextPageToken) == 0 {
 			break
 		}
 	}
 
-	return events, nil
+	data, err := temporalproto.CustomJSONMarshalOptions{Indent: "  "}.Marshal(history)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workflow history: %w", err)
+	}
+	return data, nil
+}
+
+// newHistoryPage converts a history response into a page of enhanced events.
+func newHistoryPage(resp *workflowservice.GetWorkflowExecutionHistoryResponse) *HistoryPage {
+	page := &HistoryPage{
+		NextPageToken: resp.GetNextPageToken(),
+		SizeBytes:     proto.Size(resp.GetHistory()),
+	}
+	for _, event := range resp.GetHistory().GetEvents() {
+		page.Events = append(page.Events, extractEnhancedEvent(event))
+	}
+	return page
+}
+
+// FollowWorkflowHistory long-polls for history events after pageToken.
+func (c *Client) FollowWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*HistoryPage, error) {
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		NextPageToken:          pageToken,
+		WaitNewEvent:           true,
+		HistoryEventFilterType: enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to follow workflow history: %w", err)
+	}
+
+	return newHistoryPage(resp), nil
 }
 
 // extractEnhancedEvent extracts structured data from a history event for tree/timeline views.
@@ -868,6 +1135,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetWorkflowType() != nil {
 				he.ChildWorkflowType = attrs.GetWorkflowType().GetName()
@@ -880,6 +1148,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetResult() != nil {
 				he.Result = formatPayloads(attrs.GetResult())
@@ -892,6 +1161,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 			if attrs.GetFailure() != nil {
 				he.Failure = attrs.GetFailure().GetMessage()
@@ -904,6 +1174,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -913,6 +1184,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -922,6 +1194,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 			}
 		}
 
@@ -929,6 +1202,7 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 		attrs := event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes()
 		if attrs != nil && attrs.GetWorkflowExecution() != nil {
 			he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+			he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
 		}
 
 	case enums.EVENT_TYPE_EXTERNAL_WORKFLOW_EXECUTION_SIGNALED:
@@ -937,6 +1211,42 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 			he.InitiatedEventID = attrs.GetInitiatedEventId()
 			if attrs.GetWorkflowExecution() != nil {
 				he.ChildWorkflowID = attrs.GetWorkflowExecution().GetWorkflowId()
+				he.ChildRunID = attrs.GetWorkflowExecution().GetRunId()
+			}
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		attrs := event.GetWorkflowExecutionUpdateAdmittedEventAttributes()
+		if attrs != nil {
+			applyUpdateRequest(&he, attrs.GetRequest())
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
+		attrs := event.GetWorkflowExecutionUpdateAcceptedEventAttributes()
+		if attrs != nil {
+			applyUpdateRequest(&he, attrs.GetAcceptedRequest())
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
+		attrs := event.GetWorkflowExecutionUpdateCompletedEventAttributes()
+		if attrs != nil {
+			he.UpdateID = attrs.GetMeta().GetUpdateId()
+			he.Identity = attrs.GetMeta().GetIdentity()
+			if outcome := attrs.GetOutcome(); outcome != nil {
+				if outcome.GetFailure() != nil {
+					he.Failure = outcome.GetFailure().GetMessage()
+				} else if outcome.GetSuccess() != nil {
+					he.Result = formatPayloads(outcome.GetSuccess())
+				}
+			}
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_REJECTED:
+		attrs := event.GetWorkflowExecutionUpdateRejectedEventAttributes()
+		if attrs != nil {
+			applyUpdateRequest(&he, attrs.GetRejectedRequest())
+			if attrs.GetFailure() != nil {
+				he.Failure = attrs.GetFailure().GetMessage()
 			}
 		}
 	}
@@ -944,6 +1254,19 @@ func extractEnhancedEvent(event *historypb.HistoryEvent) EnhancedHistoryEvent {
 	return he
 }
 
+// applyUpdateRequest copies the update ID, name and arguments of an update request onto an event.
+func applyUpdateRequest(he *EnhancedHistoryEvent, req *updatepb.Request) {
+	if req == nil {
+		return
+	}
+	he.UpdateID = req.GetMeta().GetUpdateId()
+	he.Identity = req.GetMeta().GetIdentity()
+	he.UpdateName = req.GetInput().GetName()
+	if req.GetInput().GetArgs() != nil {
+		he.UpdateInput = formatPayloads(req.GetInput().GetArgs())
+	}
+}
+
 // formatEventType cleans up the event type string for display
 func formatEventType(eventType string) string {
 	// Remove EVENT_TYPE_ prefix if present (older protobuf format)
@@ -1230,14 +1553,16 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			}
 		}
 
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ADMITTED:
+		attrs := event.GetWorkflowExecutionUpdateAdmittedEventAttributes()
+		if attrs != nil {
+			details = append(details, updateRequestDetails(attrs.GetRequest())...)
+		}
+
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_ACCEPTED:
 		attrs := event.GetWorkflowExecutionUpdateAcceptedEventAttributes()
 		if attrs != nil {
-			if attrs.GetAcceptedRequest() != nil {
-				if attrs.GetAcceptedRequest().GetMeta() != nil {
-					details = append(details, fmt.Sprintf("UpdateId: %s", attrs.GetAcceptedRequest().GetMeta().GetUpdateId()))
-				}
-			}
+			details = append(details, updateRequestDetails(attrs.GetAcceptedRequest())...)
 		}
 
 	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_COMPLETED:
@@ -1246,6 +1571,22 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 			if attrs.GetMeta() != nil {
 				details = append(details, fmt.Sprintf("UpdateId: %s", attrs.GetMeta().GetUpdateId()))
 			}
+			if outcome := attrs.GetOutcome(); outcome != nil {
+				if outcome.GetFailure() != nil {
+					details = append(details, fmt.Sprintf("Failure: %s", outcome.GetFailure().GetMessage()))
+				} else if outcome.GetSuccess() != nil {
+					details = append(details, fmt.Sprintf("Result: %s", formatPayloads(outcome.GetSuccess())))
+				}
+			}
+		}
+
+	case enums.EVENT_TYPE_WORKFLOW_EXECUTION_UPDATE_REJECTED:
+		attrs := event.GetWorkflowExecutionUpdateRejectedEventAttributes()
+		if attrs != nil {
+			details = append(details, updateRequestDetails(attrs.GetRejectedRequest())...)
+			if attrs.GetFailure() != nil {
+				details = append(details, fmt.Sprintf("Failure: %s", attrs.GetFailure().GetMessage()))
+			}
 		}
 
 	case enums.EVENT_TYPE_START_CHILD_WORKFLOW_EXECUTION_INITIATED:
@@ -1368,6 +1709,21 @@ func extractEventDetails(event *historypb.HistoryEvent) string {
 	return strings.Join(details, ", ")
 }
 
+// updateRequestDetails returns the detail lines for an update request.
+func updateRequestDetails(req *updatepb.Request) []string {
+	if req == nil {
+		return nil
+	}
+	details := []string{fmt.Sprintf("UpdateId: %s", req.GetMeta().GetUpdateId())}
+	if req.GetInput().GetName() != "" {
+		details = append(details, fmt.Sprintf("Name: %s", req.GetInput().GetName()))
+	}
+	if req.GetInput().GetArgs() != nil {
+		details = append(details, fmt.Sprintf("Input: %s", formatPayloads(req.GetInput().GetArgs())))
+	}
+	return details
+}
+
 // formatPayloads formats payloads for display
 func formatPayloads(payloads *commonpb.Payloads) string {
 	if payloads == nil {
@@ -1394,6 +1750,12 @@ func formatPayloads(payloads *commonpb.Payloads) string {
 			}
 		}
 
+		// Binary protobuf without a loaded descriptor can't be shown as text
+		if string(p.GetMetadata()["encoding"]) == encodingBinaryProtobuf {
+			results = append(results, fmt.Sprintf("[%s %s, %d bytes]", encodingBinaryProtobuf, p.GetMetadata()["messageType"], len(data)))
+			continue
+		}
+
 		// Fall back to raw string (truncated)
 		s := string(data)
 		if len(s) > 100 {
@@ -1487,30 +1849,181 @@ func formatDuration(d *durationpb.Duration) string {
 }
 
 // CancelWorkflow requests graceful cancellation of a workflow execution.
+// The raw RPC is used because the SDK client is bound to the connection namespace.
 func (c *Client) CancelWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
-	return c.client.CancelWorkflow(ctx, workflowID, runID)
+	_, err := c.client.WorkflowService().RequestCancelWorkflowExecution(ctx, &workflowservice.RequestCancelWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:    reason,
+		Identity:  clientIdentity(),
+		RequestId: uuid.NewString(),
+	})
+	return err
 }
 
 // TerminateWorkflow forcefully terminates a workflow execution immediately.
 func (c *Client) TerminateWorkflow(ctx context.Context, namespace, workflowID, runID, reason string) error {
-	return c.client.TerminateWorkflow(ctx, workflowID, runID, reason)
+	_, err := c.client.WorkflowService().TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Reason:   reason,
+		Identity: clientIdentity(),
+	})
+	return err
 }
 
 // SignalWorkflow sends a signal to a running workflow execution.
 func (c *Client) SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error {
-	return c.client.SignalWorkflow(ctx, workflowID, runID, signalName, input)
-}
-
-// SignalWithStartWorkflow starts a workflow if it doesn't exist and sends a signal to it.
-func (c *Client) SignalWithStartWorkflow(ctx context.Context, namespace string, req SignalWithStartRequest) (string, error) {
-	opts := client.StartWorkflowOptions{
-		ID:        req.WorkflowID,
-		TaskQueue: req.TaskQueue,
+	payloads, err := encodeJSONInput(converter.GetDefaultDataConverter(), input)
+	if err != nil {
+		return fmt.Errorf("failed to encode signal input: %w", err)
 	}
 
-	run, err := c.client.SignalWithStartWorkflow(
-		ctx,
-		req.WorkflowID,
+	_, err = c.client.WorkflowService().SignalWorkflowExecution(ctx, &workflowservice.SignalWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		SignalName: signalName,
+		Input:      payloads,
+		Identity:   clientIdentity(),
+		RequestId:  uuid.NewString(),
+	})
+	return err
+}
+
+// StartWorkflow starts a new workflow execution and returns its run ID.
+func (c *Client) StartWorkflow(ctx context.Context, namespace string, req StartWorkflowRequest) (string, error) {
+	startReq, err := buildStartWorkflowRequest(namespace, req)
+	if err != nil {
+		return "", fmt.Errorf("failed to start workflow: %w", err)
+	}
+
+	resp, err := c.client.WorkflowService().StartWorkflowExecution(ctx, startReq)
+	if err != nil {
+		return "", fmt.Errorf("failed to start workflow: %w", err)
+	}
+	return resp.GetRunId(), nil
+}
+
+// buildStartWorkflowRequest converts a StartWorkflowRequest into its RPC form.
+func buildStartWorkflowRequest(namespace string, req StartWorkflowRequest) (*workflowservice.StartWorkflowExecutionRequest, error) {
+	if req.WorkflowType == "" || req.TaskQueue == "" {
+		return nil, fmt.Errorf("workflow type and task queue are required")
+	}
+
+	workflowID := req.WorkflowID
+	if workflowID == "" {
+		workflowID = uuid.NewString()
+	}
+
+	dc := converter.GetDefaultDataConverter()
+	input, err := encodeJSONInput(dc, req.Input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode workflow input: %w", err)
+	}
+
+	startReq := &workflowservice.StartWorkflowExecutionRequest{
+		Namespace:    namespace,
+		WorkflowId:   workflowID,
+		WorkflowType: &commonpb.WorkflowType{Name: req.WorkflowType},
+		TaskQueue: &taskqueue.TaskQueue{
+			Name: req.TaskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		},
+		Input:     input,
+		RequestId: uuid.NewString(),
+	}
+
+	if req.ExecutionTimeout > 0 {
+		startReq.WorkflowExecutionTimeout = durationpb.New(req.ExecutionTimeout)
+	}
+	if req.RunTimeout > 0 {
+		startReq.WorkflowRunTimeout = durationpb.New(req.RunTimeout)
+	}
+	if req.TaskTimeout > 0 {
+		startReq.WorkflowTaskTimeout = durationpb.New(req.TaskTimeout)
+	}
+	if req.StartDelay > 0 {
+		startReq.WorkflowStartDelay = durationpb.New(req.StartDelay)
+	}
+
+	if req.IDReusePolicy != "" {
+		policy, err := enums.WorkflowIdReusePolicyFromString(req.IDReusePolicy)
+		if err != nil {
+			return nil, err
+		}
+		startReq.WorkflowIdReusePolicy = policy
+	}
+	if req.IDConflictPolicy != "" {
+		policy, err := enums.WorkflowIdConflictPolicyFromString(req.IDConflictPolicy)
+		if err != nil {
+			return nil, err
+		}
+		startReq.WorkflowIdConflictPolicy = policy
+	}
+
+	if len(req.Memo) > 0 {
+		fields, err := encodePayloadMap(dc, req.Memo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode memo: %w", err)
+		}
+		startReq.Memo = &commonpb.Memo{Fields: fields}
+	}
+	if len(req.SearchAttributes) > 0 {
+		fields, err := encodePayloadMap(dc, req.SearchAttributes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode search attributes: %w", err)
+		}
+		startReq.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: fields}
+	}
+
+	return startReq, nil
+}
+
+// encodeJSONInput encodes JSON input as a single JSON payload.
+// Input that is not valid JSON is sent as a plain string.
+func encodeJSONInput(dc converter.DataConverter, input []byte) (*commonpb.Payloads, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(input, &value); err != nil {
+		value = string(input)
+	}
+	return dc.ToPayloads(value)
+}
+
+// encodePayloadMap encodes each value of a memo or search attribute map as a payload.
+func encodePayloadMap(dc converter.DataConverter, values map[string]any) (map[string]*commonpb.Payload, error) {
+	fields := make(map[string]*commonpb.Payload, len(values))
+	for k, v := range values {
+		payload, err := dc.ToPayload(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		fields[k] = payload
+	}
+	return fields, nil
+}
+
+// SignalWithStartWorkflow starts a workflow if it doesn't exist and sends a signal to it.
+func (c *Client) SignalWithStartWorkflow(ctx context.Context, namespace string, req SignalWithStartRequest) (string, error) {
+	opts := client.StartWorkflowOptions{
+		ID:        req.WorkflowID,
+		TaskQueue: req.TaskQueue,
+	}
+
+	run, err := c.client.SignalWithStartWorkflow(
+		ctx,
+		req.WorkflowID,
 		req.SignalName,
 		req.SignalInput,
 		opts,
@@ -1523,6 +2036,140 @@ func (c *Client) SignalWithStartWorkflow(ctx context.Context, namespace string,
 	return run.GetRunID(), nil
 }
 
+// UpdateWorkflow sends an update to a running workflow and waits for it to complete.
+func (c *Client) UpdateWorkflow(ctx context.Context, namespace string, req UpdateWorkflowRequest) (*UpdateResult, error) {
+	updateReq, err := buildUpdateWorkflowRequest(namespace, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	}
+
+	resp, err := c.client.WorkflowService().UpdateWorkflowExecution(ctx, updateReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update workflow: %w", err)
+	}
+	return newUpdateResult(updateReq.GetRequest().GetMeta().GetUpdateId(), resp), nil
+}
+
+// UpdateWithStartWorkflow starts a workflow if needed and sends it an update in one call.
+func (c *Client) UpdateWithStartWorkflow(ctx context.Context, namespace string, start StartWorkflowRequest, update UpdateWorkflowRequest) (*UpdateResult, error) {
+	if start.WorkflowID == "" {
+		start.WorkflowID = uuid.NewString()
+	}
+	if start.IDConflictPolicy == "" {
+		start.IDConflictPolicy = IDConflictUseExisting
+	}
+	update.WorkflowID = start.WorkflowID
+	update.RunID = ""
+
+	startReq, err := buildStartWorkflowRequest(namespace, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start: %w", err)
+	}
+	updateReq, err := buildUpdateWorkflowRequest(namespace, update)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start: %w", err)
+	}
+
+	resp, err := c.client.WorkflowService().ExecuteMultiOperation(ctx, &workflowservice.ExecuteMultiOperationRequest{
+		Namespace: namespace,
+		Operations: []*workflowservice.ExecuteMultiOperationRequest_Operation{
+			{Operation: &workflowservice.ExecuteMultiOperationRequest_Operation_StartWorkflow{StartWorkflow: startReq}},
+			{Operation: &workflowservice.ExecuteMultiOperationRequest_Operation_UpdateWorkflow{UpdateWorkflow: updateReq}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update with start: %w", err)
+	}
+
+	var runID string
+	var updateResp *workflowservice.UpdateWorkflowExecutionResponse
+	for _, r := range resp.GetResponses() {
+		if sr := r.GetStartWorkflow(); sr != nil {
+			runID = sr.GetRunId()
+		}
+		if ur := r.GetUpdateWorkflow(); ur != nil {
+			updateResp = ur
+		}
+	}
+	if updateResp == nil {
+		return nil, fmt.Errorf("failed to update with start: no update response")
+	}
+
+	result := newUpdateResult(updateReq.GetRequest().GetMeta().GetUpdateId(), updateResp)
+	if runID != "" {
+		result.RunID = runID
+	}
+	return result, nil
+}
+
+// buildUpdateWorkflowRequest converts an UpdateWorkflowRequest into its RPC form.
+// The request waits for the update to complete.
+func buildUpdateWorkflowRequest(namespace string, req UpdateWorkflowRequest) (*workflowservice.UpdateWorkflowExecutionRequest, error) {
+	if req.UpdateName == "" {
+		return nil, fmt.Errorf("update name is required")
+	}
+
+	updateID := req.UpdateID
+	if updateID == "" {
+		updateID = uuid.NewString()
+	}
+
+	args, err := encodeJSONInput(converter.GetDefaultDataConverter(), req.Input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode update input: %w", err)
+	}
+
+	return &workflowservice.UpdateWorkflowExecutionRequest{
+		Namespace: namespace,
+		WorkflowExecution: &commonpb.WorkflowExecution{
+			WorkflowId: req.WorkflowID,
+			RunId:      req.RunID,
+		},
+		WaitPolicy: &updatepb.WaitPolicy{
+			LifecycleStage: enums.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+		},
+		Request: &updatepb.Request{
+			Meta: &updatepb.Meta{UpdateId: updateID},
+			Input: &updatepb.Input{
+				Name: req.UpdateName,
+				Args: args,
+			},
+		},
+	}, nil
+}
+
+// newUpdateResult converts an update response into an UpdateResult.
+func newUpdateResult(updateID string, resp *workflowservice.UpdateWorkflowExecutionResponse) *UpdateResult {
+	result := &UpdateResult{
+		UpdateID: updateID,
+		RunID:    resp.GetUpdateRef().GetWorkflowExecution().GetRunId(),
+		Stage:    resp.GetStage().String(),
+	}
+
+	outcome := resp.GetOutcome()
+	if outcome == nil {
+		return result
+	}
+	if failure := outcome.GetFailure(); failure != nil {
+		result.Failure = failure.GetMessage()
+		return result
+	}
+
+	var value interface{}
+	if outcome.GetSuccess() != nil {
+		if err := converter.GetDefaultDataConverter().FromPayloads(outcome.GetSuccess(), &value); err != nil {
+			result.Failure = fmt.Sprintf("failed to decode update result: %v", err)
+			return result
+		}
+	}
+	if b, err := json.MarshalIndent(value, "", "  "); err == nil {
+		result.Result = string(b)
+	} else {
+		result.Result = fmt.Sprintf("%v", value)
+	}
+	return result
+}
+
 // DeleteWorkflow permanently deletes a workflow execution and its history.
 func (c *Client) DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error {
 	_, err := c.client.WorkflowService().DeleteWorkflowExecution(ctx,
@@ -1553,100 +2200,559 @@ func (c *Client) ResetWorkflow(ctx context.Context, namespace, workflowID, runID
 	return resp.GetRunId(), nil
 }
 
-// ListSchedules returns all schedules in a namespace.
+// PauseActivity stops a pending activity from being retried until it is unpaused.
+func (c *Client) PauseActivity(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error {
+	_, err := c.client.WorkflowService().PauseActivity(ctx, &workflowservice.PauseActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Activity: &workflowservice.PauseActivityRequest_Id{Id: activityID},
+		Reason:   reason,
+	})
+	return err
+}
+
+// UnpauseActivity lets a paused activity be scheduled again.
+func (c *Client) UnpauseActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetAttempts bool) error {
+	_, err := c.client.WorkflowService().UnpauseActivity(ctx, &workflowservice.UnpauseActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Activity:      &workflowservice.UnpauseActivityRequest_Id{Id: activityID},
+		ResetAttempts: resetAttempts,
+	})
+	return err
+}
+
+// ResetActivity resets a pending activity to its first attempt and schedules it immediately.
+func (c *Client) ResetActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetHeartbeat, keepPaused bool) error {
+	_, err := c.client.WorkflowService().ResetActivity(ctx, &workflowservice.ResetActivityRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Activity:       &workflowservice.ResetActivityRequest_Id{Id: activityID},
+		ResetHeartbeat: resetHeartbeat,
+		KeepPaused:     keepPaused,
+	})
+	return err
+}
+
+// UpdateActivityOptions changes the timeouts and retry policy of a pending activity.
+func (c *Client) UpdateActivityOptions(ctx context.Context, namespace, workflowID, runID, activityID string, opts ActivityOptions) (*ActivityOptions, error) {
+	options, mask := activityOptionsUpdate(opts)
+	if len(mask.GetPaths()) == 0 {
+		return nil, fmt.Errorf("no activity options to update")
+	}
+
+	resp, err := c.client.WorkflowService().UpdateActivityOptions(ctx, &workflowservice.UpdateActivityOptionsRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Activity:        &workflowservice.UpdateActivityOptionsRequest_Id{Id: activityID},
+		ActivityOptions: options,
+		UpdateMask:      mask,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newActivityOptions(resp.GetActivityOptions()), nil
+}
+
+// ListSchedules returns a page of schedules in a namespace and the token for the next page.
 func (c *Client) ListSchedules(ctx context.Context, namespace string, opts ListOptions) ([]Schedule, string, error) {
+	if c.client == nil {
+		return nil, "", fmt.Errorf("client not connected")
+	}
+
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
 		pageSize = 100
 	}
 
-	resp, err := c.client.ScheduleClient().List(ctx, client.ScheduleListOptions{
-		PageSize: pageSize,
+	// The SDK's schedule iterator walks every page, so page through the service directly
+	resp, err := c.client.WorkflowService().ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+		Namespace:       namespace,
+		MaximumPageSize: int32(pageSize),
+		NextPageToken:   []byte(opts.PageToken),
+		Query:           opts.Query,
 	})
 	if err != nil {
 		return nil, "", fmt.Errorf("failed to list schedules: %w", err)
 	}
 
 	var schedules []Schedule
-	for resp.HasNext() {
-		entry, err := resp.Next()
-		if err != nil {
-			return nil, "", fmt.Errorf("failed to iterate schedules: %w", err)
-		}
-
+	for _, entry := range resp.GetSchedules() {
+		info := entry.GetInfo()
 		schedule := Schedule{
-			ID:           entry.ID,
-			Paused:       entry.Paused,
-			Notes:        entry.Note,
-			WorkflowType: entry.WorkflowType.Name,
+			ID:           entry.GetScheduleId(),
+			Paused:       info.GetPaused(),
+			Notes:        info.GetNotes(),
+			WorkflowType: info.GetWorkflowType().GetName(),
 		}
 
-		// Extract spec info
-		if entry.Spec != nil && len(entry.Spec.Intervals) > 0 {
-			schedule.Spec = formatScheduleSpec(entry.Spec)
+		if info.GetSpec() != nil {
+			spec, _ := scheduleSpecFromProto(info.GetSpec()) // Only summarized here
+			schedule.Spec = spec.String()
 		}
 
 		// Recent and future actions
-		if len(entry.RecentActions) > 0 {
-			lastAction := entry.RecentActions[len(entry.RecentActions)-1]
-			t := lastAction.ActualTime
+		if recent := info.GetRecentActions(); len(recent) > 0 {
+			t := recent[len(recent)-1].GetActualTime().AsTime()
 			schedule.LastRunTime = &t
 		}
-		if len(entry.NextActionTimes) > 0 {
-			t := entry.NextActionTimes[0]
+		if future := info.GetFutureActionTimes(); len(future) > 0 {
+			t := future[0].AsTime()
 			schedule.NextRunTime = &t
 		}
 
 		schedules = append(schedules, schedule)
 	}
 
-	return schedules, "", nil
+	return schedules, string(resp.GetNextPageToken()), nil
 }
 
-// GetSchedule returns details for a specific schedule.
+// GetSchedule returns details for a specific schedule, including its full definition.
 func (c *Client) GetSchedule(ctx context.Context, namespace, scheduleID string) (*Schedule, error) {
-	handle := c.client.ScheduleClient().GetHandle(ctx, scheduleID)
-	desc, err := handle.Describe(ctx)
+	if c.client == nil {
+		return nil, fmt.Errorf("client not connected")
+	}
+
+	resp, err := c.client.WorkflowService().DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: scheduleID,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to describe schedule: %w", err)
 	}
 
+	def, defErr := scheduleDefinitionFromProto(scheduleID, resp.GetSchedule())
+	def.ConflictToken = resp.GetConflictToken()
 	schedule := &Schedule{
+		ID:            scheduleID,
+		Spec:          def.Spec.String(),
+		WorkflowType:  def.Action.WorkflowType,
+		WorkflowID:    def.Action.WorkflowID,
+		TaskQueue:     def.Action.TaskQueue,
+		Paused:        def.Paused,
+		Notes:         def.Notes,
+		OverlapPolicy: def.Policy.Overlap,
+		Definition:    &def,
+	}
+	if defErr != nil {
+		schedule.Definition = nil // Saving it would drop the settings that couldn't be read
+	}
+
+	// Info from description
+	info := resp.GetInfo()
+	schedule.TotalActions = info.GetActionCount()
+	if recent := info.GetRecentActions(); len(recent) > 0 {
+		t := recent[len(recent)-1].GetActualTime().AsTime()
+		schedule.LastRunTime = &t
+	}
+	if future := info.GetFutureActionTimes(); len(future) > 0 {
+		t := future[0].AsTime()
+		schedule.NextRunTime = &t
+	}
+
+	return schedule, nil
+}
+
+// CreateSchedule creates a schedule from a full definition.
+func (c *Client) CreateSchedule(ctx context.Context, namespace string, def ScheduleDefinition) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	if err := def.Validate(); err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	schedule := &schedulepb.Schedule{}
+	if err := applyScheduleDefinition(schedule, ScheduleDefinition{}, def, c.dataConverter(ctx, namespace)); err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	_, err := c.client.WorkflowService().CreateSchedule(ctx, &workflowservice.CreateScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: def.ID,
+		Schedule:   schedule,
+		Identity:   clientIdentity(),
+		RequestId:  uuid.NewString(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create schedule: %w", err)
+	}
+	return nil
+}
+
+// UpdateSchedule applies def to the schedule as it is on the server, so settings a
+// definition doesn't model (action timeouts and retry policy, excluded calendars,
+// action limits and so on) are kept. The conflict token makes the server reject the
+// update if the schedule changed since def was read.
+func (c *Client) UpdateSchedule(ctx context.Context, namespace string, def ScheduleDefinition) error {
+	if c.client == nil {
+		return fmt.Errorf("client not connected")
+	}
+	if err := def.Validate(); err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	// Payloads are read as stored, so the ones left unchanged are sent back still encoded
+	resp, err := c.client.WorkflowService().DescribeSchedule(withRawPayloads(ctx), &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: def.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	token := resp.GetConflictToken()
+	if def.ConflictToken != nil && !bytes.Equal(def.ConflictToken, token) {
+		return fmt.Errorf("failed to update schedule: %s changed since it was loaded, reload it and edit again", def.ID)
+	}
+
+	// Compare against a decoded copy, as def was read through the decoding interceptors
+	schedule := resp.GetSchedule()
+	decoded := proto.Clone(schedule).(*schedulepb.Schedule)
+	if err := c.decodeMessage(ctx, namespace, decoded); err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	loaded, err := scheduleDefinitionFromProto(def.ID, decoded)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	if err := applyScheduleDefinition(schedule, loaded, def, c.dataConverter(ctx, namespace)); err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	_, err = c.client.WorkflowService().UpdateSchedule(ctx, &workflowservice.UpdateScheduleRequest{
+		Namespace:     namespace,
+		ScheduleId:    def.ID,
+		Schedule:      schedule,
+		ConflictToken: token,
+		Identity:      clientIdentity(),
+		RequestId:     uuid.NewString(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// applyScheduleDefinition writes def onto schedule, leaving the settings a definition
+// doesn't model as they are. loaded is the definition read from schedule; input, memo
+// and search attributes that still match it keep their payloads, so values in
+// encodings the editor only shows as text are not re-encoded. Changed input and memo
+// are encoded with dc.
+func applyScheduleDefinition(schedule *schedulepb.Schedule, loaded, def ScheduleDefinition, dc converter.DataConverter) error {
+	if schedule.Spec == nil {
+		schedule.Spec = &schedulepb.ScheduleSpec{}
+	}
+	spec, edited := schedule.Spec, scheduleSpecToProto(def.Spec)
+	if spec.GetTimezoneName() != edited.GetTimezoneName() {
+		spec.TimezoneData = nil // Embedded zone data would override the new name
+	}
+	spec.CronString = edited.CronString
+	spec.Calendar = nil // String calendars were read into structured ones
+	spec.StructuredCalendar = edited.StructuredCalendar
+	spec.Interval = edited.Interval
+	spec.Jitter = edited.Jitter
+	spec.TimezoneName = edited.TimezoneName
+	spec.StartTime = edited.StartTime
+	spec.EndTime = edited.EndTime
+
+	wf := schedule.GetAction().GetStartWorkflow()
+	if wf == nil {
+		wf = &workflowpb.NewWorkflowExecutionInfo{}
+		schedule.Action = &schedulepb.ScheduleAction{
+			Action: &schedulepb.ScheduleAction_StartWorkflow{StartWorkflow: wf},
+		}
+	}
+	wf.WorkflowId = def.Action.WorkflowID
+	if wf.WorkflowId == "" {
+		wf.WorkflowId = def.ID
+	}
+	wf.WorkflowType = &commonpb.WorkflowType{Name: def.Action.WorkflowType}
+	if wf.GetTaskQueue().GetName() != def.Action.TaskQueue {
+		wf.TaskQueue = &taskqueue.TaskQueue{
+			Name: def.Action.TaskQueue,
+			Kind: enums.TASK_QUEUE_KIND_NORMAL,
+		}
+	}
+
+	if !sameJSON(json.RawMessage(loaded.Action.Input), json.RawMessage(def.Action.Input)) {
+		input, err := encodeJSONInput(dc, def.Action.Input)
+		if err != nil {
+			return fmt.Errorf("failed to encode workflow input: %w", err)
+		}
+		wf.Input = input
+	}
+	if !sameJSON(loaded.Action.Memo, def.Action.Memo) {
+		wf.Memo = nil
+		if len(def.Action.Memo) > 0 {
+			fields, err := encodePayloadMap(dc, def.Action.Memo)
+			if err != nil {
+				return fmt.Errorf("failed to encode memo: %w", err)
+			}
+			wf.Memo = &commonpb.Memo{Fields: fields}
+		}
+	}
+	if !sameJSON(loaded.Action.SearchAttributes, def.Action.SearchAttributes) {
+		wf.SearchAttributes = nil
+		if len(def.Action.SearchAttributes) > 0 {
+			// The server indexes search attributes, so they never go through the codec
+			fields, err := encodePayloadMap(converter.GetDefaultDataConverter(), def.Action.SearchAttributes)
+			if err != nil {
+				return fmt.Errorf("failed to encode search attributes: %w", err)
+			}
+			wf.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: fields}
+		}
+	}
+
+	if schedule.Policies == nil {
+		schedule.Policies = &schedulepb.SchedulePolicies{}
+	}
+	policies := schedule.Policies
+	policies.PauseOnFailure = def.Policy.PauseOnFailure
+	policies.OverlapPolicy = enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED
+	if def.Policy.Overlap != "" {
+		overlap, err := enums.ScheduleOverlapPolicyFromString(def.Policy.Overlap)
+		if err != nil {
+			return err
+		}
+		policies.OverlapPolicy = overlap
+	}
+	policies.CatchupWindow = nil
+	if def.Policy.CatchupWindow > 0 {
+		policies.CatchupWindow = durationpb.New(def.Policy.CatchupWindow)
+	}
+
+	if schedule.State == nil {
+		schedule.State = &schedulepb.ScheduleState{}
+	}
+	schedule.State.Notes = def.Notes
+	schedule.State.Paused = def.Paused
+	return nil
+}
+
+// dataConverter returns the converter for payloads sent to namespace, which encodes
+// through the remote codec when one is configured.
+func (c *Client) dataConverter(ctx context.Context, namespace string) converter.DataConverter {
+	dc := converter.GetDefaultDataConverter()
+	if c.codec == nil {
+		return dc
+	}
+	return converter.NewCodecDataConverter(dc, c.codec.payloadCodec(ctx, namespace))
+}
+
+// decodeMessage decodes the payloads in msg in place, as the response interceptors do
+// for requests that don't ask for raw payloads.
+func (c *Client) decodeMessage(ctx context.Context, namespace string, msg proto.Message) error {
+	if c.codec != nil {
+		if err := c.codec.decodeMessage(ctx, namespace, msg); err != nil {
+			return fmt.Errorf("failed to decode payloads: %w", err)
+		}
+	}
+	if c.protos != nil {
+		return c.protos.decodeMessage(ctx, msg)
+	}
+	return nil
+}
+
+// sameJSON reports whether a and b encode to the same JSON.
+func sameJSON(a, b any) bool {
+	x, errA := json.Marshal(a)
+	y, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(x, y)
+}
+
+// scheduleDefinitionFromProto converts a described schedule back into a definition.
+// It fails if the spec has calendars a ScheduleSpec can't represent.
+func scheduleDefinitionFromProto(scheduleID string, schedule *schedulepb.Schedule) (ScheduleDefinition, error) {
+	spec, err := scheduleSpecFromProto(schedule.GetSpec())
+	def := ScheduleDefinition{
 		ID:     scheduleID,
-		Paused: desc.Schedule.State.Paused,
-		Notes:  desc.Schedule.State.Note,
+		Spec:   spec,
+		Paused: schedule.GetState().GetPaused(),
+		Notes:  schedule.GetState().GetNotes(),
+		Policy: SchedulePolicy{
+			CatchupWindow:  schedule.GetPolicies().GetCatchupWindow().AsDuration(),
+			PauseOnFailure: schedule.GetPolicies().GetPauseOnFailure(),
+		},
+	}
+	if overlap := schedule.GetPolicies().GetOverlapPolicy(); overlap != enums.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		def.Policy.Overlap = overlap.String()
 	}
 
-	// Extract workflow info from action
-	if desc.Schedule.Action != nil {
-		if startAction, ok := desc.Schedule.Action.(*client.ScheduleWorkflowAction); ok {
-			// Workflow is an interface{} representing the workflow type
-			if wfType, ok := startAction.Workflow.(string); ok {
-				schedule.WorkflowType = wfType
+	if wf := schedule.GetAction().GetStartWorkflow(); wf != nil {
+		def.Action = ScheduleAction{
+			WorkflowID:   wf.GetWorkflowId(),
+			WorkflowType: wf.GetWorkflowType().GetName(),
+			TaskQueue:    wf.GetTaskQueue().GetName(),
+			Input:        decodeJSONInput(wf.GetInput()),
+		}
+		for name, value := range decodeMemo(wf.GetMemo()) {
+			if def.Action.Memo == nil {
+				def.Action.Memo = make(map[string]any)
+			}
+			var v any
+			if err := json.Unmarshal([]byte(value), &v); err != nil {
+				v = value
+			}
+			def.Action.Memo[name] = v
+		}
+		for name, attr := range decodeSearchAttributes(wf.GetSearchAttributes()) {
+			if def.Action.SearchAttributes == nil {
+				def.Action.SearchAttributes = make(map[string]any)
 			}
-			schedule.WorkflowID = startAction.ID
-			schedule.TaskQueue = startAction.TaskQueue
+			def.Action.SearchAttributes[name] = attr.Value
 		}
 	}
+	return def, err
+}
 
-	// Extract spec info
-	if desc.Schedule.Spec != nil {
-		schedule.Spec = formatScheduleSpec(desc.Schedule.Spec)
+// decodeJSONInput turns workflow input payloads back into the JSON encodeJSONInput reads:
+// a single argument as itself and several as an array.
+func decodeJSONInput(payloads *commonpb.Payloads) []byte {
+	var args []json.RawMessage
+	for _, p := range payloads.GetPayloads() {
+		if json.Valid(p.GetData()) {
+			args = append(args, p.GetData())
+			continue
+		}
+		// Not JSON (e.g. binary or protobuf); keep a readable form
+		text, _ := json.Marshal(formatPayloads(&commonpb.Payloads{Payloads: []*commonpb.Payload{p}}))
+		args = append(args, text)
+	}
+	switch len(args) {
+	case 0:
+		return nil
+	case 1:
+		return args[0]
+	default:
+		data, _ := json.Marshal(args)
+		return data
 	}
+}
 
-	// Info from description
-	schedule.TotalActions = int64(desc.Info.NumActions)
-	if len(desc.Info.RecentActions) > 0 {
-		lastAction := desc.Info.RecentActions[len(desc.Info.RecentActions)-1]
-		t := lastAction.ActualTime
-		schedule.LastRunTime = &t
+// scheduleSpecToProto converts a ScheduleSpec into its RPC form.
+func scheduleSpecToProto(spec ScheduleSpec) *schedulepb.ScheduleSpec {
+	result := &schedulepb.ScheduleSpec{
+		CronString:   spec.CronExpressions,
+		TimezoneName: spec.TimeZone,
 	}
-	if len(desc.Info.NextActionTimes) > 0 {
-		t := desc.Info.NextActionTimes[0]
-		schedule.NextRunTime = &t
+	for _, interval := range spec.Intervals {
+		result.Interval = append(result.Interval, &schedulepb.IntervalSpec{
+			Interval: durationpb.New(interval.Every),
+			Phase:    durationpb.New(interval.Offset),
+		})
+	}
+	rangesToProto := func(ranges []ScheduleRange) []*schedulepb.Range {
+		var result []*schedulepb.Range
+		for _, r := range ranges {
+			result = append(result, &schedulepb.Range{Start: r.Start, End: r.End, Step: r.Step})
+		}
+		return result
+	}
+	for _, cal := range spec.Calendars {
+		result.StructuredCalendar = append(result.StructuredCalendar, &schedulepb.StructuredCalendarSpec{
+			Second:     rangesToProto(cal.Second),
+			Minute:     rangesToProto(cal.Minute),
+			Hour:       rangesToProto(cal.Hour),
+			DayOfMonth: rangesToProto(cal.DayOfMonth),
+			Month:      rangesToProto(cal.Month),
+			Year:       rangesToProto(cal.Year),
+			DayOfWeek:  rangesToProto(cal.DayOfWeek),
+			Comment:    cal.Comment,
+		})
+	}
+	if spec.Jitter > 0 {
+		result.Jitter = durationpb.New(spec.Jitter)
 	}
+	if spec.StartTime != nil {
+		result.StartTime = timestamppb.New(*spec.StartTime)
+	}
+	if spec.EndTime != nil {
+		result.EndTime = timestamppb.New(*spec.EndTime)
+	}
+	return result
+}
 
-	return schedule, nil
+// scheduleSpecFromProto converts a schedule's RPC spec into a ScheduleSpec.
+// String calendars that can't be parsed are left out and reported as an error.
+func scheduleSpecFromProto(spec *schedulepb.ScheduleSpec) (ScheduleSpec, error) {
+	result := ScheduleSpec{
+		CronExpressions: spec.GetCronString(),
+		Jitter:          spec.GetJitter().AsDuration(),
+		TimeZone:        spec.GetTimezoneName(),
+	}
+	for _, interval := range spec.GetInterval() {
+		result.Intervals = append(result.Intervals, ScheduleInterval{
+			Every:  interval.GetInterval().AsDuration(),
+			Offset: interval.GetPhase().AsDuration(),
+		})
+	}
+	rangesFromProto := func(ranges []*schedulepb.Range) []ScheduleRange {
+		var result []ScheduleRange
+		for _, r := range ranges {
+			result = append(result, ScheduleRange{Start: r.GetStart(), End: r.GetEnd(), Step: r.GetStep()})
+		}
+		return result
+	}
+	for _, cal := range spec.GetStructuredCalendar() {
+		result.Calendars = append(result.Calendars, ScheduleCalendar{
+			Second:     rangesFromProto(cal.GetSecond()),
+			Minute:     rangesFromProto(cal.GetMinute()),
+			Hour:       rangesFromProto(cal.GetHour()),
+			DayOfMonth: rangesFromProto(cal.GetDayOfMonth()),
+			Month:      rangesFromProto(cal.GetMonth()),
+			Year:       rangesFromProto(cal.GetYear()),
+			DayOfWeek:  rangesFromProto(cal.GetDayOfWeek()),
+			Comment:    cal.GetComment(),
+		})
+	}
+	// Servers compile string calendars into structured ones, but older ones may send them as is
+	var unsupported error
+	for _, cal := range spec.GetCalendar() {
+		var fields []string
+		for _, f := range []struct{ name, value string }{
+			{"second", cal.GetSecond()}, {"minute", cal.GetMinute()}, {"hour", cal.GetHour()},
+			{"dayOfMonth", cal.GetDayOfMonth()}, {"month", cal.GetMonth()}, {"year", cal.GetYear()},
+			{"dayOfWeek", cal.GetDayOfWeek()},
+		} {
+			if f.value != "" {
+				fields = append(fields, f.name+"="+f.value)
+			}
+		}
+		if len(fields) == 0 {
+			fields = append(fields, "hour=0")
+		}
+		text := strings.Join(fields, " ")
+		parsed, err := ParseScheduleCalendar(text)
+		if err != nil {
+			if unsupported == nil {
+				unsupported = fmt.Errorf("calendar %q is not supported: %w", text, err)
+			}
+			continue
+		}
+		parsed.Comment = cal.GetComment()
+		result.Calendars = append(result.Calendars, parsed)
+	}
+	if spec.GetStartTime() != nil {
+		t := spec.GetStartTime().AsTime()
+		result.StartTime = &t
+	}
+	if spec.GetEndTime() != nil {
+		t := spec.GetEndTime().AsTime()
+		result.EndTime = &t
+	}
+	return result, unsupported
 }
 
 // PauseSchedule pauses a schedule.
@@ -1677,37 +2783,6 @@ func (c *Client) DeleteSchedule(ctx context.Context, namespace, scheduleID strin
 	return handle.Delete(ctx)
 }
 
-// formatScheduleSpec creates a human-readable schedule specification.
-func formatScheduleSpec(spec *client.ScheduleSpec) string {
-	if spec == nil {
-		return ""
-	}
-
-	var parts []string
-
-	// Check for cron expressions
-	if len(spec.CronExpressions) > 0 {
-		parts = append(parts, spec.CronExpressions[0])
-	}
-
-	// Check for intervals
-	if len(spec.Intervals) > 0 {
-		interval := spec.Intervals[0]
-		parts = append(parts, fmt.Sprintf("every %s", interval.Every))
-	}
-
-	// Check for calendars
-	if len(spec.Calendars) > 0 {
-		parts = append(parts, "calendar-based")
-	}
-
-	if len(parts) == 0 {
-		return "custom"
-	}
-
-	return strings.Join(parts, ", ")
-}
-
 // QueryWorkflow executes a query against a running workflow and returns the result.
 func (c *Client) QueryWorkflow(ctx context.Context, namespace, workflowID, runID, queryType string, args []byte) (*QueryResult, error) {
 	// Build query input if args provided
@@ -1719,24 +2794,52 @@ func (c *Client) QueryWorkflow(ctx context.Context, namespace, workflowID, runID
 		}
 	}
 
-	// Execute the query
-	response, err := c.client.QueryWorkflow(ctx, workflowID, runID, queryType, queryArgs)
+	dc := converter.GetDefaultDataConverter()
+	var queryPayloads *commonpb.Payloads
+	if queryArgs != nil {
+		var err error
+		queryPayloads, err = dc.ToPayloads(queryArgs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode query args: %w", err)
+		}
+	}
+
+	// Execute the query against the requested namespace
+	response, err := c.client.WorkflowService().QueryWorkflow(ctx, &workflowservice.QueryWorkflowRequest{
+		Namespace: namespace,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		Query: &querypb.WorkflowQuery{
+			QueryType: queryType,
+			QueryArgs: queryPayloads,
+		},
+	})
 	if err != nil {
 		return &QueryResult{
 			QueryType: queryType,
 			Error:     err.Error(),
 		}, nil
 	}
-
-	// Decode the result
-	var result interface{}
-	if err := response.Get(&result); err != nil {
+	if rejected := response.GetQueryRejected(); rejected != nil {
 		return &QueryResult{
 			QueryType: queryType,
-			Error:     fmt.Sprintf("failed to decode query result: %v", err),
+			Error:     fmt.Sprintf("query rejected: workflow status %s", rejected.GetStatus()),
 		}, nil
 	}
 
+	// Decode the result
+	var result interface{}
+	if response.GetQueryResult() != nil {
+		if err := dc.FromPayloads(response.GetQueryResult(), &result); err != nil {
+			return &QueryResult{
+				QueryType: queryType,
+				Error:     fmt.Sprintf("failed to decode query result: %v", err),
+			}, nil
+		}
+	}
+
 	// Format result as JSON for display
 	resultJSON, err := json.MarshalIndent(result, "", "  ")
 	if err != nil {
@@ -1757,7 +2860,7 @@ func (c *Client) CancelWorkflows(ctx context.Context, namespace string, workflow
 	results := make([]BatchResult, len(workflows))
 
 	for i, wf := range workflows {
-		err := c.client.CancelWorkflow(ctx, wf.WorkflowID, wf.RunID)
+		err := c.CancelWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, "")
 		results[i] = BatchResult{
 			WorkflowID: wf.WorkflowID,
 			RunID:      wf.RunID,
@@ -1776,7 +2879,7 @@ func (c *Client) TerminateWorkflows(ctx context.Context, namespace string, workf
 	results := make([]BatchResult, len(workflows))
 
 	for i, wf := range workflows {
-		err := c.client.TerminateWorkflow(ctx, wf.WorkflowID, wf.RunID, reason)
+		err := c.TerminateWorkflow(ctx, namespace, wf.WorkflowID, wf.RunID, reason)
 		results[i] = BatchResult{
 			WorkflowID: wf.WorkflowID,
 			RunID:      wf.RunID,
@@ -1797,7 +2900,11 @@ func (c *Client) GetResetPoints(ctx context.Context, namespace, workflowID, runI
 	if err != nil {
 		return nil, err
 	}
+	return ResetPointsFromHistory(events), nil
+}
 
+// ResetPointsFromHistory derives reset points from an enhanced event history.
+func ResetPointsFromHistory(events []EnhancedHistoryEvent) []ResetPoint {
 	var resetPoints []ResetPoint
 
 	// Track activity/timer state for building descriptions
@@ -1868,7 +2975,7 @@ func (c *Client) GetResetPoints(ctx context.Context, namespace, workflowID, runI
 		}
 	}
 
-	return resetPoints, nil
+	return resetPoints
 }
 
 // truncateString truncates a string to maxLen and adds ellipsis if needed.
@@ -1881,3 +2988,190 @@ func truncateString(s string, maxLen int) string {
 
 // Ensure Client implements Provider
 var _ Provider = (*Client)(nil)
+
+// CountWorkflows returns the number of workflows matching a visibility query,
+// grouped by groupBy when it is set.
+func (c *Client) CountWorkflows(ctx context.Context, namespace, query, groupBy string) (int64, []WorkflowCountGroup, error) {
+	if c.client == nil {
+		return 0, nil, fmt.Errorf("client not connected")
+	}
+
+	if groupBy != "" {
+		// GROUP BY must come before ORDER BY, which doesn't matter for a count
+		query = strings.TrimSpace(withoutOrderBy(query) + " GROUP BY " + groupBy)
+	}
+	resp, err := c.client.WorkflowService().CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     query,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to count workflows: %w", err)
+	}
+	if groupBy == "" {
+		return resp.GetCount(), nil, nil
+	}
+
+	dc := converter.GetDefaultDataConverter()
+	groups := make([]WorkflowCountGroup, 0, len(resp.GetGroups()))
+	for _, g := range resp.GetGroups() {
+		var values []string
+		for _, p := range g.GetGroupValues() {
+			var v string
+			if err := dc.FromPayload(p, &v); err != nil {
+				v = string(p.GetData())
+			}
+			values = append(values, v)
+		}
+		groups = append(groups, WorkflowCountGroup{
+			Value: strings.Join(values, ", "),
+			Count: g.GetCount(),
+		})
+	}
+	return resp.GetCount(), groups, nil
+}
+
+// StartBatchOperation starts a server-side batch job scoped by a visibility query.
+func (c *Client) StartBatchOperation(ctx context.Context, namespace string, req BatchOperationRequest) (string, error) {
+	if req.Query == "" {
+		return "", fmt.Errorf("failed to start batch operation: a visibility query is required")
+	}
+
+	jobID := req.JobID
+	if jobID == "" {
+		jobID = uuid.NewString()
+	}
+
+	batchReq := &workflowservice.StartBatchOperationRequest{
+		Namespace:       namespace,
+		VisibilityQuery: req.Query,
+		JobId:           jobID,
+		Reason:          req.Reason,
+	}
+
+	// The start request has no identity field, so it goes on the operation
+	identity := clientIdentity()
+	switch req.Type {
+	case BatchTerminate:
+		batchReq.Operation = &workflowservice.StartBatchOperationRequest_TerminationOperation{
+			TerminationOperation: &batchpb.BatchOperationTermination{Identity: identity},
+		}
+	case BatchCancel:
+		batchReq.Operation = &workflowservice.StartBatchOperationRequest_CancellationOperation{
+			CancellationOperation: &batchpb.BatchOperationCancellation{Identity: identity},
+		}
+	case BatchSignal:
+		if req.SignalName == "" {
+			return "", fmt.Errorf("failed to start batch operation: signal name is required")
+		}
+		input, err := encodeJSONInput(converter.GetDefaultDataConverter(), req.SignalInput)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode signal input: %w", err)
+		}
+		batchReq.Operation = &workflowservice.StartBatchOperationRequest_SignalOperation{
+			SignalOperation: &batchpb.BatchOperationSignal{
+				Signal:   req.SignalName,
+				Input:    input,
+				Identity: identity,
+			},
+		}
+	case BatchDelete:
+		batchReq.Operation = &workflowservice.StartBatchOperationRequest_DeletionOperation{
+			DeletionOperation: &batchpb.BatchOperationDeletion{Identity: identity},
+		}
+	case BatchReset:
+		options := &commonpb.ResetOptions{}
+		switch req.ResetType {
+		case ResetFirstWorkflowTask:
+			options.Target = &commonpb.ResetOptions_FirstWorkflowTask{FirstWorkflowTask: &emptypb.Empty{}}
+		case ResetLastWorkflowTask, "":
+			options.Target = &commonpb.ResetOptions_LastWorkflowTask{LastWorkflowTask: &emptypb.Empty{}}
+		default:
+			return "", fmt.Errorf("failed to start batch operation: unknown reset type %q", req.ResetType)
+		}
+		batchReq.Operation = &workflowservice.StartBatchOperationRequest_ResetOperation{
+			ResetOperation: &batchpb.BatchOperationReset{Options: options, Identity: identity},
+		}
+	default:
+		return "", fmt.Errorf("failed to start batch operation: unknown operation %q", req.Type)
+	}
+
+	if _, err := c.client.WorkflowService().StartBatchOperation(ctx, batchReq); err != nil {
+		return "", fmt.Errorf("failed to start batch operation: %w", err)
+	}
+	return jobID, nil
+}
+
+// ListBatchOperations returns batch jobs in a namespace.
+func (c *Client) ListBatchOperations(ctx context.Context, namespace string, opts ListOptions) ([]BatchOperation, string, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+
+	resp, err := c.client.WorkflowService().ListBatchOperations(ctx, &workflowservice.ListBatchOperationsRequest{
+		Namespace:     namespace,
+		PageSize:      int32(pageSize),
+		NextPageToken: []byte(opts.PageToken),
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list batch operations: %w", err)
+	}
+
+	var ops []BatchOperation
+	for _, info := range resp.GetOperationInfo() {
+		op := BatchOperation{
+			JobID:     info.GetJobId(),
+			State:     info.GetState().String(),
+			StartTime: info.GetStartTime().AsTime(),
+		}
+		if info.GetCloseTime() != nil && !info.GetCloseTime().AsTime().IsZero() {
+			t := info.GetCloseTime().AsTime()
+			op.CloseTime = &t
+		}
+		ops = append(ops, op)
+	}
+
+	return ops, string(resp.GetNextPageToken()), nil
+}
+
+// DescribeBatchOperation returns a batch job with its progress counts.
+func (c *Client) DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*BatchOperation, error) {
+	resp, err := c.client.WorkflowService().DescribeBatchOperation(ctx, &workflowservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe batch operation: %w", err)
+	}
+
+	op := &BatchOperation{
+		JobID:          resp.GetJobId(),
+		Type:           resp.GetOperationType().String(),
+		State:          resp.GetState().String(),
+		StartTime:      resp.GetStartTime().AsTime(),
+		TotalCount:     resp.GetTotalOperationCount(),
+		CompletedCount: resp.GetCompleteOperationCount(),
+		FailureCount:   resp.GetFailureOperationCount(),
+		Reason:         resp.GetReason(),
+		Identity:       resp.GetIdentity(),
+	}
+	if resp.GetCloseTime() != nil && !resp.GetCloseTime().AsTime().IsZero() {
+		t := resp.GetCloseTime().AsTime()
+		op.CloseTime = &t
+	}
+	return op, nil
+}
+
+// StopBatchOperation stops a running batch job.
+func (c *Client) StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error {
+	_, err := c.client.WorkflowService().StopBatchOperation(ctx, &workflowservice.StopBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		Identity:  clientIdentity(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop batch operation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package temporal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// codecTimeout bounds a single request to the codec server.
+const codecTimeout = 10 * time.Second
+
+// rawPayloadsKey marks a request context whose response payloads must not be decoded.
+type rawPayloadsKey struct{}
+
+// withRawPayloads returns a context that bypasses the payload decoding interceptors.
+func withRawPayloads(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rawPayloadsKey{}, true)
+}
+
+// rawPayloads reports whether payload decoding is bypassed for ctx.
+func rawPayloads(ctx context.Context) bool {
+	raw, _ := ctx.Value(rawPayloadsKey{}).(bool)
+	return raw
+}
+
+// remoteCodec encodes and decodes payloads through a remote codec server using the
+// Temporal remote codec protocol: payloads are POSTed as JSON to <endpoint>/encode
+// or <endpoint>/decode.
+type remoteCodec struct {
+	endpoint string
+	client   *http.Client
+}
+
+// newRemoteCodec creates a codec for the given endpoint, or nil if none is configured.
+func newRemoteCodec(endpoint string) *remoteCodec {
+	endpoint = strings.TrimSuffix(strings.TrimSpace(endpoint), "/")
+	if endpoint == "" {
+		return nil
+	}
+	return &remoteCodec{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: codecTimeout},
+	}
+}
+
+// decode sends payloads to the codec server and returns the decoded payloads in order.
+func (rc *remoteCodec) decode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return rc.post(ctx, namespace, "/decode", payloads)
+}
+
+// encode sends payloads to the codec server and returns the encoded payloads in order.
+func (rc *remoteCodec) encode(ctx context.Context, namespace string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return rc.post(ctx, namespace, "/encode", payloads)
+}
+
+// post sends payloads to one of the codec server's endpoints and returns its payloads.
+func (rc *remoteCodec) post(ctx context.Context, namespace, path string, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	body, err := protojson.Marshal(&commonpb.Payloads{Payloads: payloads})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode payloads: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rc.endpoint+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build codec request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if namespace != "" {
+		req.Header.Set("X-Namespace", namespace)
+	}
+
+	resp, err := rc.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("codec request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read codec response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("codec server returned %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+
+	var result commonpb.Payloads
+	if err := protojson.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse codec response: %w", err)
+	}
+	if len(result.GetPayloads()) != len(payloads) {
+		return nil, fmt.Errorf("codec server returned %d payloads, expected %d", len(result.GetPayloads()), len(payloads))
+	}
+	return result.GetPayloads(), nil
+}
+
+// payloadCodec returns the codec as an SDK PayloadCodec for requests to namespace,
+// so a data converter can encode through it.
+func (rc *remoteCodec) payloadCodec(ctx context.Context, namespace string) converter.PayloadCodec {
+	return &namespaceCodec{rc: rc, ctx: ctx, namespace: namespace}
+}
+
+// namespaceCodec binds a remote codec to the context and namespace of one request.
+type namespaceCodec struct {
+	rc        *remoteCodec
+	ctx       context.Context
+	namespace string
+}
+
+func (nc *namespaceCodec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return nc.rc.encode(nc.ctx, nc.namespace, payloads)
+}
+
+func (nc *namespaceCodec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	return nc.rc.decode(nc.ctx, nc.namespace, payloads)
+}
+
+// decodeMessage decodes every payload in a message in place with a single codec request.
+// Search attributes are left alone since the server indexes them unencoded.
+func (rc *remoteCodec) decodeMessage(ctx context.Context, namespace string, msg proto.Message) error {
+	// First pass collects payloads in visit order, second pass swaps in the decoded ones.
+	var all []*commonpb.Payload
+	err := proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			all = append(all, payloads...)
+			return payloads, nil
+		},
+		SkipSearchAttributes: true,
+	})
+	if err != nil || len(all) == 0 {
+		return err
+	}
+
+	decoded, err := rc.decode(ctx, namespace, all)
+	if err != nil {
+		return err
+	}
+
+	offset := 0
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := decoded[offset : offset+len(payloads)]
+			offset += len(payloads)
+			return result, nil
+		},
+		SkipSearchAttributes: true,
+	})
+}
+
+// interceptor returns a gRPC interceptor that decodes payloads in every response.
+// If the codec server fails, payloads are left encoded so views still render.
+func (rc *remoteCodec) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil || rawPayloads(ctx) {
+			return err
+		}
+
+		msg, ok := reply.(proto.Message)
+		if !ok {
+			return nil
+		}
+		var namespace string
+		if r, ok := req.(interface{ GetNamespace() string }); ok {
+			namespace = r.GetNamespace()
+		}
+		if err := rc.decodeMessage(ctx, namespace, msg); err != nil && sdkLogger != nil {
+			sdkLogger.Warn("payload decode failed", "method", method, "error", err)
+		}
+		return nil
+	}
+}
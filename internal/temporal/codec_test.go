@@ -0,0 +1,248 @@
+package temporal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// testEncoding marks payloads encoded by the stand-in codec: their data is reversed.
+const testEncoding = "binary/reversed"
+
+// encodeTestPayload encodes JSON the way the stand-in codec server expects.
+func encodeTestPayload(json string) *commonpb.Payload {
+	data := []byte(json)
+	slices.Reverse(data)
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte(testEncoding)},
+		Data:     data,
+	}
+}
+
+// newTestCodecServer starts a stand-in remote codec that encodes JSON payloads the
+// way encodeTestPayload does, decodes them back and records the namespace of the
+// last request.
+func newTestCodecServer(t *testing.T, namespace *string) *httptest.Server {
+	t.Helper()
+	handler := func(from, to string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			*namespace = r.Header.Get("X-Namespace")
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			var payloads commonpb.Payloads
+			if err := protojson.Unmarshal(body, &payloads); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for _, p := range payloads.GetPayloads() {
+				if string(p.GetMetadata()["encoding"]) != from {
+					continue
+				}
+				slices.Reverse(p.Data)
+				p.Metadata = map[string][]byte{"encoding": []byte(to)}
+			}
+			data, _ := protojson.Marshal(&payloads)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write(data)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /decode", handler(testEncoding, "json/plain"))
+	mux.HandleFunc("POST /encode", handler("json/plain", testEncoding))
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestHistory returns a history response with encoded input and an encoded
+// search attribute, which must stay as it is.
+func newTestHistory() *workflowservice.GetWorkflowExecutionHistoryResponse {
+	return &workflowservice.GetWorkflowExecutionHistoryResponse{
+		History: &historypb.History{Events: []*historypb.HistoryEvent{{
+			EventId: 1,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					Input: &commonpb.Payloads{Payloads: []*commonpb.Payload{
+						encodeTestPayload(`{"order":1}`),
+						encodeTestPayload(`"second"`),
+					}},
+					SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+						"CustomKeyword": encodeTestPayload(`"indexed"`),
+					}},
+				},
+			},
+		}}},
+	}
+}
+
+func startedAttributes(resp *workflowservice.GetWorkflowExecutionHistoryResponse) *historypb.WorkflowExecutionStartedEventAttributes {
+	return resp.GetHistory().GetEvents()[0].GetWorkflowExecutionStartedEventAttributes()
+}
+
+func TestRemoteCodecDecodeMessage(t *testing.T) {
+	var namespace string
+	server := newTestCodecServer(t, &namespace)
+	rc := newRemoteCodec(server.URL + "/")
+
+	resp := newTestHistory()
+	if err := rc.decodeMessage(context.Background(), "orders", resp); err != nil {
+		t.Fatalf("decodeMessage: %v", err)
+	}
+
+	attrs := startedAttributes(resp)
+	var got []string
+	for _, p := range attrs.GetInput().GetPayloads() {
+		got = append(got, string(p.GetData()))
+		if enc := string(p.GetMetadata()["encoding"]); enc != "json/plain" {
+			t.Errorf("encoding = %q, want json/plain", enc)
+		}
+	}
+	if want := []string{`{"order":1}`, `"second"`}; !slices.Equal(got, want) {
+		t.Errorf("input = %q, want %q", got, want)
+	}
+	if sa := attrs.GetSearchAttributes().GetIndexedFields()["CustomKeyword"]; string(sa.GetMetadata()["encoding"]) != testEncoding {
+		t.Errorf("search attribute was decoded: %v", sa)
+	}
+	if namespace != "orders" {
+		t.Errorf("X-Namespace = %q, want orders", namespace)
+	}
+}
+
+func TestRemoteCodecDecodeMessageError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "key unavailable", http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+	rc := newRemoteCodec(server.URL)
+
+	resp := newTestHistory()
+	want := proto.Clone(resp)
+	if err := rc.decodeMessage(context.Background(), "orders", resp); err == nil {
+		t.Fatal("decodeMessage succeeded against a failing codec server")
+	}
+	if !proto.Equal(resp, want) {
+		t.Error("payloads changed after a failed decode")
+	}
+}
+
+func TestRemoteCodecInterceptor(t *testing.T) {
+	var namespace string
+	server := newTestCodecServer(t, &namespace)
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failing.Close)
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		proto.Merge(reply.(proto.Message), newTestHistory())
+		return nil
+	}
+	req := &workflowservice.GetWorkflowExecutionHistoryRequest{Namespace: "orders"}
+	firstInput := func(resp *workflowservice.GetWorkflowExecutionHistoryResponse) string {
+		return string(startedAttributes(resp).GetInput().GetPayloads()[0].GetData())
+	}
+	encoded := firstInput(newTestHistory())
+
+	tests := []struct {
+		name     string
+		endpoint string
+		ctx      context.Context
+		want     string
+	}{
+		{"decodes", server.URL, context.Background(), `{"order":1}`},
+		{"raw payloads bypass the codec", server.URL, withRawPayloads(context.Background()), encoded},
+		{"codec failure leaves payloads encoded", failing.URL, context.Background(), encoded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply := &workflowservice.GetWorkflowExecutionHistoryResponse{}
+			err := newRemoteCodec(tt.endpoint).interceptor()(tt.ctx, "/GetWorkflowExecutionHistory", req, reply, nil, invoker)
+			if err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+			if got := firstInput(reply); got != tt.want {
+				t.Errorf("input = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoteCodecEncode(t *testing.T) {
+	var namespace string
+	server := newTestCodecServer(t, &namespace)
+	rc := newRemoteCodec(server.URL)
+
+	plain := &commonpb.Payload{Metadata: map[string][]byte{"encoding": []byte("json/plain")}, Data: []byte(`{"order":1}`)}
+	encoded, err := rc.encode(context.Background(), "orders", []*commonpb.Payload{plain})
+	if err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	if want := encodeTestPayload(`{"order":1}`); !proto.Equal(encoded[0], want) {
+		t.Errorf("encoded = %v, want %v", encoded[0], want)
+	}
+	if namespace != "orders" {
+		t.Errorf("X-Namespace = %q, want orders", namespace)
+	}
+}
+
+func TestApplyScheduleDefinitionCodec(t *testing.T) {
+	var namespace string
+	server := newTestCodecServer(t, &namespace)
+	c := &Client{codec: newRemoteCodec(server.URL)}
+	ctx := context.Background()
+
+	// A schedule as stored: input and memo encoded, search attributes plain
+	stored := &schedulepb.Schedule{Action: &schedulepb.ScheduleAction{
+		Action: &schedulepb.ScheduleAction_StartWorkflow{StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{
+			WorkflowType: &commonpb.WorkflowType{Name: "OrderWorkflow"},
+			TaskQueue:    &taskqueuepb.TaskQueue{Name: "orders"},
+			Input:        &commonpb.Payloads{Payloads: []*commonpb.Payload{encodeTestPayload(`{"order":1}`)}},
+			Memo:         &commonpb.Memo{Fields: map[string]*commonpb.Payload{"owner": encodeTestPayload(`"ops"`)}},
+		}},
+	}}
+	decoded := proto.Clone(stored).(*schedulepb.Schedule)
+	if err := c.decodeMessage(ctx, "orders", decoded); err != nil {
+		t.Fatalf("decodeMessage: %v", err)
+	}
+	loaded, err := scheduleDefinitionFromProto("orders-nightly", decoded)
+	if err != nil {
+		t.Fatalf("scheduleDefinitionFromProto: %v", err)
+	}
+	if string(loaded.Action.Input) != `{"order":1}` || loaded.Action.Memo["owner"] != "ops" {
+		t.Fatalf("loaded action = %+v, want the decoded input and memo", loaded.Action)
+	}
+
+	def := loaded
+	def.Action.Memo = map[string]any{"owner": "billing"}
+	def.Action.SearchAttributes = map[string]any{"Region": "eu"}
+	if err := applyScheduleDefinition(stored, loaded, def, c.dataConverter(ctx, "orders")); err != nil {
+		t.Fatalf("applyScheduleDefinition: %v", err)
+	}
+
+	wf := stored.GetAction().GetStartWorkflow()
+	if got := wf.GetInput().GetPayloads()[0]; !proto.Equal(got, encodeTestPayload(`{"order":1}`)) {
+		t.Errorf("unchanged input = %v, want the stored payload", got)
+	}
+	if got := wf.GetMemo().GetFields()["owner"]; string(got.GetMetadata()["encoding"]) != testEncoding {
+		t.Errorf("changed memo = %v, want it encoded by the codec", got)
+	}
+	if got := wf.GetSearchAttributes().GetIndexedFields()["Region"]; string(got.GetMetadata()["encoding"]) != "json/plain" {
+		t.Errorf("search attribute = %v, want it left for the server to index", got)
+	}
+}
@@ -17,6 +17,7 @@ const (
 	GroupChildWorkflow
 	GroupSignal
 	GroupMarker
+	GroupUpdate
 	GroupOther
 )
 
@@ -37,6 +38,8 @@ func (g EventGroupType) String() string {
 		return "Signal"
 	case GroupMarker:
 		return "Marker"
+	case GroupUpdate:
+		return "Update"
 	default:
 		return "Other"
 	}
@@ -66,40 +69,88 @@ func (n *EventTreeNode) HasChildren() bool {
 	return len(n.Children) > 0
 }
 
-// BuildEventTree constructs a tree from a flat list of enhanced history events.
-func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
-	if len(events) == 0 {
-		return nil
+// ChildWorkflow returns the execution started for a child workflow node. The run ID
+// is empty until the ChildWorkflowExecutionStarted event has been recorded.
+func (n *EventTreeNode) ChildWorkflow() (workflowID, runID string) {
+	if n.Type != GroupChildWorkflow {
+		return "", ""
 	}
-
-	// Create index for O(1) lookups by event ID
-	eventMap := make(map[int64]*EnhancedHistoryEvent)
-	for i := range events {
-		eventMap[events[i].ID] = &events[i]
+	for _, ev := range n.Events {
+		if ev.ChildWorkflowID != "" {
+			workflowID = ev.ChildWorkflowID
+		}
+		if ev.ChildRunID != "" {
+			runID = ev.ChildRunID
+		}
 	}
+	return workflowID, runID
+}
 
-	var rootNodes []*EventTreeNode
+// BuildEventTree constructs a tree from a flat list of enhanced history events.
+func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
+	b := NewEventTreeBuilder()
+	b.Add(events)
+	return b.Nodes()
+}
+
+// EventTreeBuilder groups history events into tree nodes incrementally, so events
+// arriving for a followed workflow only touch the nodes they belong to.
+type EventTreeBuilder struct {
+	rootNodes []*EventTreeNode
 
 	// Track which events have been processed
-	processed := make(map[int64]bool)
+	processed map[int64]bool
 
 	// Track activity groups by ScheduledEventID
-	activityGroups := make(map[int64]*EventTreeNode)
+	activityGroups map[int64]*EventTreeNode
 
 	// Track timer groups by StartedEventID
-	timerGroups := make(map[int64]*EventTreeNode)
+	timerGroups map[int64]*EventTreeNode
 
 	// Track child workflow groups by InitiatedEventID
-	childWfGroups := make(map[int64]*EventTreeNode)
+	childWfGroups map[int64]*EventTreeNode
 
 	// Track workflow task groups by ScheduledEventID
-	wfTaskGroups := make(map[int64]*EventTreeNode)
+	wfTaskGroups map[int64]*EventTreeNode
+
+	// Track workflow update groups by UpdateID
+	updateGroups map[string]*EventTreeNode
+}
+
+// NewEventTreeBuilder creates an empty tree builder.
+func NewEventTreeBuilder() *EventTreeBuilder {
+	return &EventTreeBuilder{
+		processed:      make(map[int64]bool),
+		activityGroups: make(map[int64]*EventTreeNode),
+		timerGroups:    make(map[int64]*EventTreeNode),
+		childWfGroups:  make(map[int64]*EventTreeNode),
+		wfTaskGroups:   make(map[int64]*EventTreeNode),
+		updateGroups:   make(map[string]*EventTreeNode),
+	}
+}
+
+// Nodes returns the root nodes built so far, in history order.
+func (b *EventTreeBuilder) Nodes() []*EventTreeNode {
+	return b.rootNodes
+}
+
+// Add groups events into the tree and returns the root nodes that were created
+// or changed, in history order. Nodes reference the events in place, so the
+// slice must not be modified afterwards.
+func (b *EventTreeBuilder) Add(events []EnhancedHistoryEvent) []*EventTreeNode {
+	// Every change to an existing group appends to its events, so comparing
+	// event counts afterwards finds the groups that were touched.
+	existing := len(b.rootNodes)
+	sizes := make(map[*EventTreeNode]int, existing)
+	for _, node := range b.rootNodes {
+		sizes[node] = len(node.Events)
+	}
 
 	// First pass: identify group roots and build groups
 	for i := range events {
 		ev := &events[i]
 
-		if processed[ev.ID] {
+		if b.processed[ev.ID] {
 			continue
 		}
 
@@ -113,8 +164,55 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				StartTime: ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
+
+		// Update Admitted/Accepted - creates a new update group, or advances an admitted one
+		case ev.Type == "WorkflowExecutionUpdateAdmitted" || ev.Type == "WorkflowExecutionUpdateAccepted":
+			status := UpdateStatusAdmitted
+			if ev.Type == "WorkflowExecutionUpdateAccepted" {
+				status = UpdateStatusAccepted
+			}
+			if group, ok := b.updateGroups[ev.UpdateID]; ok {
+				group.Events = append(group.Events, ev)
+				group.Status = status
+			} else {
+				node := &EventTreeNode{
+					Name:      fmt.Sprintf("Update: %s", ev.UpdateName),
+					Type:      GroupUpdate,
+					Status:    status,
+					StartTime: ev.Time,
+					Events:    []*EnhancedHistoryEvent{ev},
+				}
+				b.updateGroups[ev.UpdateID] = node
+				b.rootNodes = append(b.rootNodes, node)
+			}
+			b.processed[ev.ID] = true
+
+		// Update terminal events
+		case ev.Type == "WorkflowExecutionUpdateCompleted" || ev.Type == "WorkflowExecutionUpdateRejected":
+			group, ok := b.updateGroups[ev.UpdateID]
+			if !ok {
+				group = &EventTreeNode{
+					Name:      fmt.Sprintf("Update: %s", ev.UpdateName),
+					Type:      GroupUpdate,
+					StartTime: ev.Time,
+				}
+				b.updateGroups[ev.UpdateID] = group
+				b.rootNodes = append(b.rootNodes, group)
+			}
+			group.Events = append(group.Events, ev)
+			switch {
+			case ev.Type == "WorkflowExecutionUpdateRejected":
+				group.Status = UpdateStatusRejected
+			case ev.Failure != "":
+				group.Status = "Failed"
+			default:
+				group.Status = "Completed"
+			}
+			group.EndTime = &ev.Time
+			group.Duration = ev.Time.Sub(group.StartTime)
+			b.processed[ev.ID] = true
 
 		// Workflow terminal events
 		case strings.HasPrefix(ev.Type, "WorkflowExecution") && ev.Type != "WorkflowExecutionStarted" && ev.Type != "WorkflowExecutionSignaled":
@@ -127,8 +225,8 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				EndTime:   &ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Activity Scheduled - creates a new activity group
 		case ev.Type == "ActivityTaskScheduled":
@@ -139,13 +237,13 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				StartTime: ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			activityGroups[ev.ID] = node
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.activityGroups[ev.ID] = node
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Activity Started - links to Scheduled
 		case ev.Type == "ActivityTaskStarted":
-			if group, ok := activityGroups[ev.ScheduledEventID]; ok {
+			if group, ok := b.activityGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
 				if ev.Attempt > 1 {
@@ -161,12 +259,12 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 					group.Children = append(group.Children, attemptNode)
 				}
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Activity terminal events
 		case ev.Type == "ActivityTaskCompleted" || ev.Type == "ActivityTaskFailed" ||
 			ev.Type == "ActivityTaskTimedOut" || ev.Type == "ActivityTaskCanceled":
-			if group, ok := activityGroups[ev.ScheduledEventID]; ok {
+			if group, ok := b.activityGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = extractActivityStatus(ev.Type)
 				group.EndTime = &ev.Time
@@ -181,7 +279,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 					lastAttempt.Duration = ev.Time.Sub(lastAttempt.StartTime)
 				}
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Timer Started - creates a new timer group
 		case ev.Type == "TimerStarted":
@@ -192,13 +290,13 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				StartTime: ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			timerGroups[ev.ID] = node
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.timerGroups[ev.ID] = node
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Timer terminal events
 		case ev.Type == "TimerFired" || ev.Type == "TimerCanceled":
-			if group, ok := timerGroups[ev.StartedEventID]; ok {
+			if group, ok := b.timerGroups[ev.StartedEventID]; ok {
 				group.Events = append(group.Events, ev)
 				if ev.Type == "TimerFired" {
 					group.Status = "Fired"
@@ -208,7 +306,7 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Child Workflow Initiated - creates a new child workflow group
 		case ev.Type == "StartChildWorkflowExecutionInitiated":
@@ -219,27 +317,27 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				StartTime: ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			childWfGroups[ev.ID] = node
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.childWfGroups[ev.ID] = node
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Child Workflow Started
 		case ev.Type == "ChildWorkflowExecutionStarted":
-			if group, ok := childWfGroups[ev.InitiatedEventID]; ok {
+			if group, ok := b.childWfGroups[ev.InitiatedEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Child Workflow terminal events
 		case strings.HasPrefix(ev.Type, "ChildWorkflowExecution") && ev.Type != "ChildWorkflowExecutionStarted":
-			if group, ok := childWfGroups[ev.InitiatedEventID]; ok {
+			if group, ok := b.childWfGroups[ev.InitiatedEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = extractChildWorkflowStatus(ev.Type)
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Workflow Task Scheduled
 		case ev.Type == "WorkflowTaskScheduled":
@@ -250,27 +348,27 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				StartTime: ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			wfTaskGroups[ev.ID] = node
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.wfTaskGroups[ev.ID] = node
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Workflow Task Started
 		case ev.Type == "WorkflowTaskStarted":
-			if group, ok := wfTaskGroups[ev.ScheduledEventID]; ok {
+			if group, ok := b.wfTaskGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = "Running"
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Workflow Task terminal events
 		case ev.Type == "WorkflowTaskCompleted" || ev.Type == "WorkflowTaskFailed" || ev.Type == "WorkflowTaskTimedOut":
-			if group, ok := wfTaskGroups[ev.ScheduledEventID]; ok {
+			if group, ok := b.wfTaskGroups[ev.ScheduledEventID]; ok {
 				group.Events = append(group.Events, ev)
 				group.Status = extractWorkflowTaskStatus(ev.Type)
 				group.EndTime = &ev.Time
 				group.Duration = ev.Time.Sub(group.StartTime)
 			}
-			processed[ev.ID] = true
+			b.processed[ev.ID] = true
 
 		// Signal events
 		case ev.Type == "WorkflowExecutionSignaled":
@@ -282,8 +380,8 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				EndTime:   &ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Marker events
 		case ev.Type == "MarkerRecorded":
@@ -295,12 +393,12 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 				EndTime:   &ev.Time,
 				Events:    []*EnhancedHistoryEvent{ev},
 			}
-			rootNodes = append(rootNodes, node)
-			processed[ev.ID] = true
+			b.rootNodes = append(b.rootNodes, node)
+			b.processed[ev.ID] = true
 
 		// Other unhandled events
 		default:
-			if !processed[ev.ID] {
+			if !b.processed[ev.ID] {
 				node := &EventTreeNode{
 					Name:      ev.Type,
 					Type:      GroupOther,
@@ -308,13 +406,19 @@ func BuildEventTree(events []EnhancedHistoryEvent) []*EventTreeNode {
 					StartTime: ev.Time,
 					Events:    []*EnhancedHistoryEvent{ev},
 				}
-				rootNodes = append(rootNodes, node)
-				processed[ev.ID] = true
+				b.rootNodes = append(b.rootNodes, node)
+				b.processed[ev.ID] = true
 			}
 		}
 	}
 
-	return rootNodes
+	var changed []*EventTreeNode
+	for i, node := range b.rootNodes {
+		if i >= existing || len(node.Events) != sizes[node] {
+			changed = append(changed, node)
+		}
+	}
+	return changed
 }
 
 // extractWorkflowStatus extracts status from workflow terminal event type.
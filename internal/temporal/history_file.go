@@ -0,0 +1,114 @@
+package temporal
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/proto"
+)
+
+// HistoryFile is a workflow history read from a JSON export.
+type HistoryFile struct {
+	Workflow Workflow
+	Events   []EnhancedHistoryEvent
+}
+
+// closeEventStatus maps the event that closes a workflow to its status.
+var closeEventStatus = map[enums.EventType]string{
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED:        StatusCompleted,
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_FAILED:           StatusFailed,
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_CANCELED:         StatusCanceled,
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_TERMINATED:       StatusTerminated,
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_TIMED_OUT:        StatusTimedOut,
+	enums.EVENT_TYPE_WORKFLOW_EXECUTION_CONTINUED_AS_NEW: StatusContinuedAsNew,
+}
+
+// LoadHistoryFile reads a history exported with `temporal workflow show --output json`
+// or tempo's own export. The workflow summary is derived from the events, since the
+// file holds nothing else.
+func LoadHistoryFile(path string) (*HistoryFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer f.Close()
+
+	history, err := client.HistoryFromJSON(f, client.HistoryJSONOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse history file: %w", err)
+	}
+	events := history.GetEvents()
+	if len(events) == 0 {
+		return nil, fmt.Errorf("history file %s has no events", path)
+	}
+
+	hf := &HistoryFile{
+		Events: make([]EnhancedHistoryEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		hf.Events = append(hf.Events, extractEnhancedEvent(event))
+	}
+
+	first, last := events[0], events[len(events)-1]
+	wf := Workflow{
+		Status:           StatusRunning,
+		StartTime:        first.GetEventTime().AsTime(),
+		HistoryLength:    int64(len(events)),
+		HistorySizeBytes: int64(proto.Size(history)),
+	}
+	if attrs := first.GetWorkflowExecutionStartedEventAttributes(); attrs != nil {
+		wf.ID = attrs.GetWorkflowId()
+		// The history never names its own run. A reset run copies its started event
+		// from the run it was reset from, so this can be an earlier run; it's only a
+		// fallback for files not named the way tempo exports them.
+		wf.RunID = attrs.GetOriginalExecutionRunId()
+		wf.Type = attrs.GetWorkflowType().GetName()
+		wf.TaskQueue = attrs.GetTaskQueue().GetName()
+		wf.Memo = decodeMemo(attrs.GetMemo())
+		wf.SearchAttributes = decodeSearchAttributes(attrs.GetSearchAttributes())
+		if parentID := attrs.GetParentWorkflowExecution().GetWorkflowId(); parentID != "" {
+			wf.ParentID = &parentID
+			wf.ParentRunID = attrs.GetParentWorkflowExecution().GetRunId()
+		}
+	}
+	workflowID, runID := historyFileIDs(path)
+	if runID != "" {
+		wf.RunID = runID
+	}
+	if wf.ID == "" {
+		// Older servers don't record the workflow ID in the history
+		wf.ID = workflowID
+	}
+	if status, ok := closeEventStatus[last.GetEventType()]; ok {
+		wf.Status = status
+		endTime := last.GetEventTime().AsTime()
+		wf.EndTime = &endTime
+	}
+	wf.Input, wf.Output = workflowInputOutput(events)
+	wf.FirstRunID, wf.PrevRunID, wf.NextRunID = workflowRunLinks(events)
+
+	hf.Workflow = wf
+	return hf, nil
+}
+
+// historyFileIDs splits a file name in the form tempo's export uses,
+// <workflow ID>_<run ID>_history.json, into its workflow and run IDs.
+// Other names give the base name, less any _history suffix, and no run ID.
+func historyFileIDs(path string) (workflowID, runID string) {
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	trimmed := strings.TrimSuffix(name, "_history")
+	if trimmed == "" {
+		trimmed = name
+	}
+	if idx := strings.LastIndex(trimmed, "_"); idx > 0 {
+		if _, err := uuid.Parse(trimmed[idx+1:]); err == nil {
+			return trimmed[:idx], trimmed[idx+1:]
+		}
+	}
+	return trimmed, ""
+}
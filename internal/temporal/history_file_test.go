@@ -0,0 +1,85 @@
+package temporal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/temporalproto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testOriginalRunID = "0b7e7a36-59a7-4c6b-9f4e-2f3a1c8d9e01"
+	testResetRunID    = "5d2c1f0e-8a4b-4e3d-a1c2-7b6e5f4d3c21"
+)
+
+// writeHistoryFile exports a started-and-completed history under name.
+func writeHistoryFile(t *testing.T, name, workflowID string) string {
+	t.Helper()
+	history := &historypb.History{Events: []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventTime: timestamppb.Now(),
+			EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					WorkflowId:             workflowID,
+					WorkflowType:           &commonpb.WorkflowType{Name: "OrderWorkflow"},
+					OriginalExecutionRunId: testOriginalRunID,
+				},
+			},
+		},
+		{
+			EventId:   2,
+			EventTime: timestamppb.Now(),
+			EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionCompletedEventAttributes{
+				WorkflowExecutionCompletedEventAttributes: &historypb.WorkflowExecutionCompletedEventAttributes{},
+			},
+		},
+	}}
+	data, err := temporalproto.CustomJSONMarshalOptions{}.Marshal(history)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadHistoryFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		workflowID string // Recorded in the started event; empty for older servers
+		wantID     string
+		wantRunID  string
+	}{
+		{"run ID from tempo's file name", "order-1_" + testResetRunID + "_history.json", "order-1", "order-1", testResetRunID},
+		{"run ID without the history suffix", "order-1_" + testResetRunID + ".json", "order-1", "order-1", testResetRunID},
+		{"other names fall back to the original run", "order-1.json", "order-1", "order-1", testOriginalRunID},
+		{"underscores in the workflow ID", "order_1_history.json", "order_1", "order_1", testOriginalRunID},
+		{"workflow ID from the file name", "order-1_" + testResetRunID + "_history.json", "", "order-1", testResetRunID},
+		{"workflow ID from a plain file name", "order-1_history.json", "", "order-1", testOriginalRunID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hf, err := LoadHistoryFile(writeHistoryFile(t, tt.file, tt.workflowID))
+			if err != nil {
+				t.Fatalf("LoadHistoryFile: %v", err)
+			}
+			if hf.Workflow.ID != tt.wantID || hf.Workflow.RunID != tt.wantRunID {
+				t.Errorf("workflow = %q/%q, want %q/%q", hf.Workflow.ID, hf.Workflow.RunID, tt.wantID, tt.wantRunID)
+			}
+			if hf.Workflow.Status != StatusCompleted || len(hf.Events) != 2 {
+				t.Errorf("status = %s with %d events, want Completed with 2", hf.Workflow.Status, len(hf.Events))
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package temporal
+
+import (
+	"time"
+
+	activitypb "go.temporal.io/api/activity/v1"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// applyPendingInfo copies what a workflow is waiting on from a describe response.
+func applyPendingInfo(wf *Workflow, resp *workflowservice.DescribeWorkflowExecutionResponse) {
+	for _, pa := range resp.GetPendingActivities() {
+		wf.PendingActivities = append(wf.PendingActivities, newPendingActivity(pa))
+	}
+
+	for _, pc := range resp.GetPendingChildren() {
+		wf.PendingChildren = append(wf.PendingChildren, PendingChild{
+			WorkflowID:        pc.GetWorkflowId(),
+			RunID:             pc.GetRunId(),
+			WorkflowType:      pc.GetWorkflowTypeName(),
+			InitiatedEventID:  pc.GetInitiatedId(),
+			ParentClosePolicy: pc.GetParentClosePolicy().String(),
+		})
+	}
+
+	for _, op := range resp.GetPendingNexusOperations() {
+		wf.PendingNexusOperations = append(wf.PendingNexusOperations, newPendingNexusOperation(op))
+	}
+
+	if wt := resp.GetPendingWorkflowTask(); wt != nil {
+		wf.PendingWorkflowTask = &PendingWorkflowTask{
+			State:                 wt.GetState().String(),
+			Attempt:               wt.GetAttempt(),
+			ScheduledTime:         optionalTime(wt.GetScheduledTime()),
+			OriginalScheduledTime: optionalTime(wt.GetOriginalScheduledTime()),
+			StartedTime:           optionalTime(wt.GetStartedTime()),
+		}
+	}
+
+	if cfg := resp.GetExecutionConfig(); cfg != nil {
+		wf.Config = &ExecutionConfig{
+			TaskQueue:           cfg.GetTaskQueue().GetName(),
+			ExecutionTimeout:    cfg.GetWorkflowExecutionTimeout().AsDuration(),
+			RunTimeout:          cfg.GetWorkflowRunTimeout().AsDuration(),
+			WorkflowTaskTimeout: cfg.GetDefaultWorkflowTaskTimeout().AsDuration(),
+		}
+	}
+}
+
+func newPendingActivity(pa *workflowpb.PendingActivityInfo) PendingActivity {
+	activity := PendingActivity{
+		ActivityID:              pa.GetActivityId(),
+		ActivityType:            pa.GetActivityType().GetName(),
+		State:                   pa.GetState().String(),
+		Attempt:                 pa.GetAttempt(),
+		MaximumAttempts:         pa.GetMaximumAttempts(),
+		ScheduledTime:           optionalTime(pa.GetScheduledTime()),
+		LastStartedTime:         optionalTime(pa.GetLastStartedTime()),
+		LastHeartbeatTime:       optionalTime(pa.GetLastHeartbeatTime()),
+		HeartbeatDetails:        formatPayloads(pa.GetHeartbeatDetails()),
+		LastWorkerIdentity:      pa.GetLastWorkerIdentity(),
+		CurrentRetryInterval:    pa.GetCurrentRetryInterval().AsDuration(),
+		LastAttemptCompleteTime: optionalTime(pa.GetLastAttemptCompleteTime()),
+		NextAttemptScheduleTime: optionalTime(pa.GetNextAttemptScheduleTime()),
+		ExpirationTime:          optionalTime(pa.GetExpirationTime()),
+		Options:                 newActivityOptions(pa.GetActivityOptions()),
+	}
+	if f := pa.GetLastFailure(); f != nil {
+		activity.LastFailure = f.GetMessage()
+		if cause := f.GetCause(); cause != nil && cause.GetMessage() != "" {
+			activity.LastFailure += ": " + cause.GetMessage()
+		}
+	}
+	return activity
+}
+
+func newPendingNexusOperation(op *workflowpb.PendingNexusOperationInfo) PendingNexusOperation {
+	return PendingNexusOperation{
+		Endpoint:                op.GetEndpoint(),
+		Service:                 op.GetService(),
+		Operation:               op.GetOperation(),
+		OperationToken:          op.GetOperationToken(),
+		State:                   op.GetState().String(),
+		Attempt:                 op.GetAttempt(),
+		ScheduledEventID:        op.GetScheduledEventId(),
+		ScheduledTime:           optionalTime(op.GetScheduledTime()),
+		ScheduleToCloseTimeout:  op.GetScheduleToCloseTimeout().AsDuration(),
+		NextAttemptScheduleTime: optionalTime(op.GetNextAttemptScheduleTime()),
+		LastAttemptFailure:      op.GetLastAttemptFailure().GetMessage(),
+		BlockedReason:           op.GetBlockedReason(),
+	}
+}
+
+func newActivityOptions(opts *activitypb.ActivityOptions) *ActivityOptions {
+	if opts == nil {
+		return nil
+	}
+	return &ActivityOptions{
+		ScheduleToCloseTimeout: opts.GetScheduleToCloseTimeout().AsDuration(),
+		ScheduleToStartTimeout: opts.GetScheduleToStartTimeout().AsDuration(),
+		StartToCloseTimeout:    opts.GetStartToCloseTimeout().AsDuration(),
+		HeartbeatTimeout:       opts.GetHeartbeatTimeout().AsDuration(),
+		InitialInterval:        opts.GetRetryPolicy().GetInitialInterval().AsDuration(),
+		BackoffCoefficient:     opts.GetRetryPolicy().GetBackoffCoefficient(),
+		MaximumInterval:        opts.GetRetryPolicy().GetMaximumInterval().AsDuration(),
+		MaximumAttempts:        opts.GetRetryPolicy().GetMaximumAttempts(),
+	}
+}
+
+// activityOptionsUpdate builds an UpdateActivityOptions payload and the field mask
+// naming the non-zero fields of opts.
+func activityOptionsUpdate(opts ActivityOptions) (*activitypb.ActivityOptions, *fieldmaskpb.FieldMask) {
+	options := &activitypb.ActivityOptions{RetryPolicy: &commonpb.RetryPolicy{}}
+	mask := &fieldmaskpb.FieldMask{}
+
+	durations := []struct {
+		path  string
+		value time.Duration
+		set   func(*durationpb.Duration)
+	}{
+		{"schedule_to_close_timeout", opts.ScheduleToCloseTimeout, func(d *durationpb.Duration) { options.ScheduleToCloseTimeout = d }},
+		{"schedule_to_start_timeout", opts.ScheduleToStartTimeout, func(d *durationpb.Duration) { options.ScheduleToStartTimeout = d }},
+		{"start_to_close_timeout", opts.StartToCloseTimeout, func(d *durationpb.Duration) { options.StartToCloseTimeout = d }},
+		{"heartbeat_timeout", opts.HeartbeatTimeout, func(d *durationpb.Duration) { options.HeartbeatTimeout = d }},
+		{"retry_policy.initial_interval", opts.InitialInterval, func(d *durationpb.Duration) { options.RetryPolicy.InitialInterval = d }},
+		{"retry_policy.maximum_interval", opts.MaximumInterval, func(d *durationpb.Duration) { options.RetryPolicy.MaximumInterval = d }},
+	}
+	for _, d := range durations {
+		if d.value > 0 {
+			d.set(durationpb.New(d.value))
+			mask.Paths = append(mask.Paths, d.path)
+		}
+	}
+	if opts.BackoffCoefficient > 0 {
+		options.RetryPolicy.BackoffCoefficient = opts.BackoffCoefficient
+		mask.Paths = append(mask.Paths, "retry_policy.backoff_coefficient")
+	}
+	if opts.MaximumAttempts > 0 {
+		options.RetryPolicy.MaximumAttempts = opts.MaximumAttempts
+		mask.Paths = append(mask.Paths, "retry_policy.maximum_attempts")
+	}
+	return options, mask
+}
+
+// optionalTime converts an unset or zero timestamp to nil.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil || ts.AsTime().IsZero() {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
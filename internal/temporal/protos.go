@@ -0,0 +1,138 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/proxy"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Payload encodings produced by the SDK proto converters.
+const (
+	encodingBinaryProtobuf = "binary/protobuf"
+	encodingJSONProtobuf   = "json/protobuf"
+	encodingJSONPlain      = "json/plain"
+)
+
+// protoDecoder renders protobuf payloads as JSON using message types loaded
+// from FileDescriptorSet files, falling back to types compiled into tempo.
+type protoDecoder struct {
+	types *dynamicpb.Types
+}
+
+// newProtoDecoder loads descriptor sets from paths, or returns nil if none are configured.
+// Sets are typically produced with `protoc --include_imports --descriptor_set_out`.
+func newProtoDecoder(paths []string) (*protoDecoder, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	set := &descriptorpb.FileDescriptorSet{}
+	seen := make(map[string]bool)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read descriptor set: %w", err)
+		}
+		var fds descriptorpb.FileDescriptorSet
+		if err := proto.Unmarshal(data, &fds); err != nil {
+			return nil, fmt.Errorf("failed to parse descriptor set %s: %w", path, err)
+		}
+		// The same file may appear in several sets; keep the first copy.
+		for _, fd := range fds.GetFile() {
+			if !seen[fd.GetName()] {
+				seen[fd.GetName()] = true
+				set.File = append(set.File, fd)
+			}
+		}
+	}
+
+	files, err := protodesc.FileOptions{AllowUnresolvable: true}.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load descriptor sets: %w", err)
+	}
+	return &protoDecoder{types: dynamicpb.NewTypes(files)}, nil
+}
+
+// findMessage resolves a message type by full name.
+func (pd *protoDecoder) findMessage(name string) (protoreflect.MessageType, error) {
+	if mt, err := pd.types.FindMessageByName(protoreflect.FullName(name)); err == nil {
+		return mt, nil
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(name))
+}
+
+// decodePayload converts a protobuf payload into a json/plain payload.
+// Payloads that are not protobuf, or whose type is unknown, are returned unchanged.
+func (pd *protoDecoder) decodePayload(p *commonpb.Payload) *commonpb.Payload {
+	encoding := string(p.GetMetadata()["encoding"])
+	if encoding != encodingBinaryProtobuf && encoding != encodingJSONProtobuf {
+		return p
+	}
+	messageType := string(p.GetMetadata()["messageType"])
+	mt, err := pd.findMessage(messageType)
+	if err != nil {
+		return p
+	}
+
+	msg := mt.New().Interface()
+	if encoding == encodingBinaryProtobuf {
+		err = proto.Unmarshal(p.GetData(), msg)
+	} else {
+		err = protojson.UnmarshalOptions{Resolver: pd.types, DiscardUnknown: true}.Unmarshal(p.GetData(), msg)
+	}
+	if err != nil {
+		return p
+	}
+	data, err := protojson.MarshalOptions{Resolver: pd.types}.Marshal(msg)
+	if err != nil {
+		return p
+	}
+
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{
+			"encoding":    []byte(encodingJSONPlain),
+			"messageType": []byte(messageType),
+		},
+		Data: data,
+	}
+}
+
+// decodeMessage converts every protobuf payload in a message to JSON in place.
+func (pd *protoDecoder) decodeMessage(ctx context.Context, msg proto.Message) error {
+	return proxy.VisitPayloads(ctx, msg, proxy.VisitPayloadsOptions{
+		Visitor: func(_ *proxy.VisitPayloadsContext, payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+			result := make([]*commonpb.Payload, len(payloads))
+			for i, p := range payloads {
+				result[i] = pd.decodePayload(p)
+			}
+			return result, nil
+		},
+		SkipSearchAttributes: true,
+	})
+}
+
+// interceptor returns a gRPC interceptor that renders protobuf payloads in every response as JSON.
+func (pd *protoDecoder) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil || rawPayloads(ctx) {
+			return err
+		}
+		if msg, ok := reply.(proto.Message); ok {
+			if err := pd.decodeMessage(ctx, msg); err != nil && sdkLogger != nil {
+				sdkLogger.Warn("protobuf payload decode failed", "method", method, "error", err)
+			}
+		}
+		return nil
+	}
+}
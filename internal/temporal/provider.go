@@ -30,6 +30,18 @@ type Provider interface {
 	// The namespace must be deprecated first before it can be deleted.
 	DeleteNamespace(ctx context.Context, name string) error
 
+	// ListSearchAttributes returns the system and custom search attributes of a namespace,
+	// sorted by name.
+	ListSearchAttributes(ctx context.Context, namespace string) ([]SearchAttributeInfo, error)
+
+	// AddSearchAttributes registers custom search attributes, mapping each name to one of
+	// the SearchAttribute* types.
+	AddSearchAttributes(ctx context.Context, namespace string, attributes map[string]string) error
+
+	// RemoveSearchAttributes removes custom search attributes from a namespace.
+	// Workflows keep the values already indexed but can no longer be filtered by them.
+	RemoveSearchAttributes(ctx context.Context, namespace string, names []string) error
+
 	// ListWorkflows returns workflows for a namespace with optional filtering.
 	ListWorkflows(ctx context.Context, namespace string, opts ListOptions) ([]Workflow, string, error)
 
@@ -42,6 +54,21 @@ type Provider interface {
 	// GetEnhancedWorkflowHistory returns event history with relational data for tree/timeline views.
 	GetEnhancedWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]EnhancedHistoryEvent, error)
 
+	// GetWorkflowHistoryPage returns one page of history events so large histories can be
+	// shown as they load. A nil page token reads the first page; an empty returned token
+	// means the whole history has been read.
+	GetWorkflowHistoryPage(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*HistoryPage, error)
+
+	// ExportWorkflowHistory returns the raw history as protojson, in the format written by
+	// `temporal workflow show --output json` and read by the SDK's WorkflowReplayer.
+	// Payloads are left as stored on the server, without codec or protobuf decoding.
+	ExportWorkflowHistory(ctx context.Context, namespace, workflowID, runID string) ([]byte, error)
+
+	// FollowWorkflowHistory long-polls for history events. A nil page token reads from the
+	// start of the history; passing the returned token waits for events after the last page.
+	// An empty returned token means the workflow has closed and no more events will arrive.
+	FollowWorkflowHistory(ctx context.Context, namespace, workflowID, runID string, pageToken []byte) (*HistoryPage, error)
+
 	// DescribeTaskQueue returns task queue info and active pollers.
 	DescribeTaskQueue(ctx context.Context, namespace, taskQueue string) (*TaskQueueInfo, []Poller, error)
 
@@ -78,16 +105,46 @@ type Provider interface {
 	// SignalWorkflow sends a signal to a running workflow execution.
 	SignalWorkflow(ctx context.Context, namespace, workflowID, runID, signalName string, input []byte) error
 
+	// StartWorkflow starts a new workflow execution.
+	// Returns the run ID of the started (or, with the UseExisting conflict policy, existing) workflow.
+	StartWorkflow(ctx context.Context, namespace string, req StartWorkflowRequest) (string, error)
+
 	// SignalWithStartWorkflow starts a workflow if it doesn't exist and sends a signal to it.
 	// Returns the run ID of the workflow.
 	SignalWithStartWorkflow(ctx context.Context, namespace string, req SignalWithStartRequest) (string, error)
 
+	// UpdateWorkflow sends an update to a running workflow and waits for it to complete.
+	// A rejected or failed update is reported in the result, not as an error.
+	UpdateWorkflow(ctx context.Context, namespace string, req UpdateWorkflowRequest) (*UpdateResult, error)
+
+	// UpdateWithStartWorkflow starts a workflow if needed and sends it an update in one call.
+	// The update targets start.WorkflowID; an empty conflict policy defaults to UseExisting.
+	UpdateWithStartWorkflow(ctx context.Context, namespace string, start StartWorkflowRequest, update UpdateWorkflowRequest) (*UpdateResult, error)
+
 	// DeleteWorkflow permanently deletes a workflow execution and its history.
 	DeleteWorkflow(ctx context.Context, namespace, workflowID, runID string) error
 
 	// ResetWorkflow resets a workflow to a previous state, creating a new run.
 	ResetWorkflow(ctx context.Context, namespace, workflowID, runID string, eventID int64, reason string) (string, error)
 
+	// Activity Operations
+
+	// PauseActivity stops a pending activity from being retried until it is unpaused.
+	// An attempt that is already running is not interrupted.
+	PauseActivity(ctx context.Context, namespace, workflowID, runID, activityID, reason string) error
+
+	// UnpauseActivity lets a paused activity be scheduled again, optionally starting
+	// over from the first attempt.
+	UnpauseActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetAttempts bool) error
+
+	// ResetActivity resets a pending activity to its first attempt and schedules it
+	// immediately. A paused activity is unpaused unless keepPaused is set.
+	ResetActivity(ctx context.Context, namespace, workflowID, runID, activityID string, resetHeartbeat, keepPaused bool) error
+
+	// UpdateActivityOptions changes the timeouts and retry policy of a pending activity.
+	// Only the non-zero fields of opts are changed. Returns the options now in effect.
+	UpdateActivityOptions(ctx context.Context, namespace, workflowID, runID, activityID string, opts ActivityOptions) (*ActivityOptions, error)
+
 	// Schedule Operations
 
 	// ListSchedules returns all schedules in a namespace.
@@ -108,6 +165,14 @@ type Provider interface {
 	// DeleteSchedule permanently deletes a schedule.
 	DeleteSchedule(ctx context.Context, namespace, scheduleID string) error
 
+	// CreateSchedule creates a schedule from a full definition.
+	CreateSchedule(ctx context.Context, namespace string, def ScheduleDefinition) error
+
+	// UpdateSchedule applies def to the schedule with def.ID. Settings a definition
+	// doesn't model, and the schedule's run history and counters, are kept. It fails
+	// if the schedule changed since def.ConflictToken was read.
+	UpdateSchedule(ctx context.Context, namespace string, def ScheduleDefinition) error
+
 	// Query Operations
 
 	// QueryWorkflow executes a query against a running workflow and returns the result.
@@ -125,6 +190,25 @@ type Provider interface {
 
 	// GetResetPoints returns valid reset points for a workflow execution.
 	GetResetPoints(ctx context.Context, namespace, workflowID, runID string) ([]ResetPoint, error)
+
+	// CountWorkflows returns the number of workflows matching a visibility query.
+	// With a groupBy attribute the count is also broken down per value of it;
+	// servers only support grouping by ExecutionStatus.
+	CountWorkflows(ctx context.Context, namespace, query, groupBy string) (int64, []WorkflowCountGroup, error)
+
+	// StartBatchOperation starts a server-side batch job over every workflow matching
+	// the request's visibility query. Returns the batch job ID.
+	StartBatchOperation(ctx context.Context, namespace string, req BatchOperationRequest) (string, error)
+
+	// ListBatchOperations returns batch jobs in a namespace, newest first.
+	// Only job ID, state and timestamps are populated; use DescribeBatchOperation for progress.
+	ListBatchOperations(ctx context.Context, namespace string, opts ListOptions) ([]BatchOperation, string, error)
+
+	// DescribeBatchOperation returns a batch job with its progress counts.
+	DescribeBatchOperation(ctx context.Context, namespace, jobID string) (*BatchOperation, error)
+
+	// StopBatchOperation stops a running batch job. Workflows already processed are not reverted.
+	StopBatchOperation(ctx context.Context, namespace, jobID, reason string) error
 }
 
 // ListOptions configures workflow list queries.
@@ -174,18 +258,115 @@ type NamespaceDetail struct {
 
 // Workflow represents a workflow execution.
 type Workflow struct {
-	ID        string
-	RunID     string
-	Type      string
-	Status    string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut"
-	Namespace string
-	TaskQueue string
-	StartTime time.Time
-	EndTime   *time.Time
-	ParentID  *string
-	Memo      map[string]string
-	Input     string // JSON-formatted workflow input
-	Output    string // JSON-formatted workflow result (or failure message)
+	ID          string
+	RunID       string
+	Type        string
+	Status      string // "Running", "Completed", "Failed", "Canceled", "Terminated", "TimedOut", "ContinuedAsNew"
+	Namespace   string
+	TaskQueue   string
+	StartTime   time.Time
+	EndTime     *time.Time
+	ParentID    *string
+	ParentRunID string
+	Memo        map[string]string // Full JSON of each memo field
+	Input       string            // JSON-formatted workflow input
+	Output      string            // JSON-formatted workflow result (or failure message)
+
+	HistoryLength    int64 // Number of events in the history
+	HistorySizeBytes int64 // Encoded size of the history
+
+	SearchAttributes map[string]SearchAttribute
+
+	// Runs linked by continue-as-new, retry, cron or reset; empty when there is none
+	FirstRunID string // First run of the chain
+	PrevRunID  string // Run this one continued from, or the run it was reset from
+	NextRunID  string // Run started when this one closed
+
+	// What the execution is waiting on; only set by GetWorkflow
+	PendingActivities      []PendingActivity
+	PendingChildren        []PendingChild
+	PendingNexusOperations []PendingNexusOperation
+	PendingWorkflowTask    *PendingWorkflowTask
+	Config                 *ExecutionConfig
+}
+
+// PendingActivity is an activity that has been scheduled and has not closed yet.
+type PendingActivity struct {
+	ActivityID         string
+	ActivityType       string
+	State              string // "Scheduled", "Started", "CancelRequested", "Paused", "PauseRequested"
+	Attempt            int32
+	MaximumAttempts    int32 // 0 means unlimited
+	ScheduledTime      *time.Time
+	LastStartedTime    *time.Time
+	LastHeartbeatTime  *time.Time
+	HeartbeatDetails   string // JSON-formatted details from the last heartbeat
+	LastFailure        string
+	LastWorkerIdentity string
+
+	// Retry state
+	CurrentRetryInterval    time.Duration // Wait before the next attempt; 0 when it won't be retried
+	LastAttemptCompleteTime *time.Time
+	NextAttemptScheduleTime *time.Time // Set while backing off between attempts
+	ExpirationTime          *time.Time // Deadline for all attempts
+
+	Options *ActivityOptions // Timeouts and retry policy in effect; nil on older servers
+}
+
+// ActivityOptions are the timeouts and retry policy of an activity.
+type ActivityOptions struct {
+	ScheduleToCloseTimeout time.Duration
+	ScheduleToStartTimeout time.Duration
+	StartToCloseTimeout    time.Duration
+	HeartbeatTimeout       time.Duration
+
+	// Retry policy
+	InitialInterval    time.Duration
+	BackoffCoefficient float64
+	MaximumInterval    time.Duration
+	MaximumAttempts    int32 // 0 means unlimited
+}
+
+// PendingChild is a child workflow that has been initiated and has not closed yet.
+type PendingChild struct {
+	WorkflowID        string
+	RunID             string
+	WorkflowType      string
+	InitiatedEventID  int64
+	ParentClosePolicy string
+}
+
+// PendingNexusOperation is a Nexus operation that has been scheduled and has not closed yet.
+type PendingNexusOperation struct {
+	Endpoint                string
+	Service                 string
+	Operation               string
+	OperationToken          string // Set once an asynchronous operation has started
+	State                   string // "Scheduled", "BackingOff", "Started", "Blocked"
+	Attempt                 int32
+	ScheduledEventID        int64
+	ScheduledTime           *time.Time
+	ScheduleToCloseTimeout  time.Duration
+	NextAttemptScheduleTime *time.Time
+	LastAttemptFailure      string
+	BlockedReason           string
+}
+
+// PendingWorkflowTask is the workflow task waiting to be picked up or completed by a worker.
+type PendingWorkflowTask struct {
+	State                 string // "Scheduled", "Started"
+	Attempt               int32
+	ScheduledTime         *time.Time
+	OriginalScheduledTime *time.Time // Unchanged by workflow task heartbeats
+	StartedTime           *time.Time
+}
+
+// ExecutionConfig is the task queue and timeouts a workflow execution runs with.
+type ExecutionConfig struct {
+	TaskQueue           string
+	ExecutionTimeout    time.Duration // 0 means unlimited
+	RunTimeout          time.Duration // 0 means unlimited
+	WorkflowTaskTimeout time.Duration
 }
 
 // HistoryEvent represents a workflow history event.
@@ -196,6 +377,13 @@ type HistoryEvent struct {
 	Details string
 }
 
+// HistoryPage is a page of history events and the token to continue reading after it.
+type HistoryPage struct {
+	Events        []EnhancedHistoryEvent
+	NextPageToken []byte // Empty when no more events will arrive
+	SizeBytes     int    // Encoded size of the events on this page
+}
+
 // EnhancedHistoryEvent extends HistoryEvent with relational fields for tree/timeline views.
 type EnhancedHistoryEvent struct {
 	ID      int64
@@ -216,6 +404,7 @@ type EnhancedHistoryEvent struct {
 	// Child workflow info
 	ChildWorkflowID   string
 	ChildWorkflowType string
+	ChildRunID        string // Set once the child has started
 
 	// Timing for Gantt view
 	EndTime *time.Time // Computed from linked completion event
@@ -226,6 +415,11 @@ type EnhancedHistoryEvent struct {
 	Identity  string
 	Failure   string
 	Result    string
+
+	// Workflow update info
+	UpdateID    string
+	UpdateName  string
+	UpdateInput string // JSON-formatted update arguments
 }
 
 // TaskQueueInfo represents task queue status information.
@@ -259,6 +453,37 @@ type Schedule struct {
 	TotalActions   int64
 	RecentActions  int64 // Actions in the last 24h
 	OverlapPolicy  string
+	Definition     *ScheduleDefinition // Full configuration; only set by GetSchedule, nil if it can't be edited
+}
+
+// ScheduleDefinition is the full configuration of a schedule, used to create or update one.
+type ScheduleDefinition struct {
+	ID     string
+	Spec   ScheduleSpec
+	Action ScheduleAction
+	Policy SchedulePolicy
+	Paused bool
+	Notes  string
+
+	ConflictToken []byte // Set by GetSchedule so an update can't overwrite a concurrent change
+}
+
+// ScheduleAction is the workflow a schedule starts on each run.
+type ScheduleAction struct {
+	WorkflowID       string // Base ID; the server appends the run time. Defaults to the schedule ID
+	WorkflowType     string
+	TaskQueue        string
+	Input            []byte // JSON-encoded workflow input
+	Memo             map[string]any
+	SearchAttributes map[string]any
+}
+
+// SchedulePolicy controls what a schedule does about overlapping, missed and failed runs.
+// Zero values fall back to server defaults.
+type SchedulePolicy struct {
+	Overlap        string        // One of the ScheduleOverlap* constants
+	CatchupWindow  time.Duration // How late a missed run may still start
+	PauseOnFailure bool
 }
 
 // ConnectionConfig holds Temporal server connection settings.
@@ -270,6 +495,17 @@ type ConnectionConfig struct {
 	TLSCAPath     string
 	TLSServerName string
 	TLSSkipVerify bool
+	TLSEnabled    bool   // Use TLS even without certificates, trusting system roots
+	CodecEndpoint string // Remote codec server URL used to decode payloads
+
+	ProtoDescriptorPaths []string // FileDescriptorSet files used to render protobuf payloads
+
+	// API key authentication; the first non-empty source wins
+	APIKey        string // Literal API key
+	APIKeyEnv     string // Environment variable holding the API key
+	APIKeyCommand string // Shell command whose stdout is the API key
+
+	Headers map[string]string // Static gRPC metadata sent with every request
 }
 
 // DefaultConnectionConfig returns default connection settings.
@@ -301,6 +537,12 @@ type BatchResult struct {
 	Error      string
 }
 
+// WorkflowCountGroup is the number of workflows sharing one value of a grouped attribute.
+type WorkflowCountGroup struct {
+	Value string // e.g. "Running" when grouped by ExecutionStatus
+	Count int64
+}
+
 // ResetPoint represents a valid point to reset a workflow to.
 type ResetPoint struct {
 	EventID     int64
@@ -319,3 +561,112 @@ type SignalWithStartRequest struct {
 	SignalInput   []byte // JSON-encoded signal input
 	WorkflowInput []byte // JSON-encoded workflow input
 }
+
+// BatchOperationType identifies the action a server-side batch job applies.
+type BatchOperationType string
+
+// Batch operation types supported by StartBatchOperation.
+const (
+	BatchTerminate BatchOperationType = "Terminate"
+	BatchCancel    BatchOperationType = "Cancel"
+	BatchSignal    BatchOperationType = "Signal"
+	BatchDelete    BatchOperationType = "Delete"
+	BatchReset     BatchOperationType = "Reset"
+)
+
+// BatchOperationTypes lists the supported batch operations in display order.
+var BatchOperationTypes = []BatchOperationType{
+	BatchTerminate,
+	BatchCancel,
+	BatchSignal,
+	BatchDelete,
+	BatchReset,
+}
+
+// Reset targets for batch reset operations.
+const (
+	ResetFirstWorkflowTask = "FirstWorkflowTask"
+	ResetLastWorkflowTask  = "LastWorkflowTask"
+)
+
+// BatchOperationRequest contains parameters for a server-side batch operation.
+type BatchOperationRequest struct {
+	Type        BatchOperationType
+	Query       string // Visibility query selecting the target workflows
+	Reason      string
+	JobID       string // Optional; generated when empty
+	SignalName  string // Signal only
+	SignalInput []byte // Signal only; JSON-encoded signal input
+	ResetType   string // Reset only; ResetFirstWorkflowTask or ResetLastWorkflowTask
+}
+
+// Batch job states reported by the server.
+const (
+	BatchStateRunning   = "Running"
+	BatchStateCompleted = "Completed"
+	BatchStateFailed    = "Failed"
+)
+
+// BatchOperation represents a server-side batch job.
+type BatchOperation struct {
+	JobID          string
+	Type           string // "Terminate", "Cancel", "Signal", "Delete", "Reset", ...
+	State          string // "Running", "Completed", "Failed"
+	StartTime      time.Time
+	CloseTime      *time.Time
+	TotalCount     int64
+	CompletedCount int64
+	FailureCount   int64
+	Reason         string
+	Identity       string
+}
+
+// ID reuse policies accepted by StartWorkflowRequest.IDReusePolicy.
+const (
+	IDReuseAllowDuplicate           = "AllowDuplicate"
+	IDReuseAllowDuplicateFailedOnly = "AllowDuplicateFailedOnly"
+	IDReuseRejectDuplicate          = "RejectDuplicate"
+	IDReuseTerminateIfRunning       = "TerminateIfRunning"
+)
+
+// ID conflict policies accepted by StartWorkflowRequest.IDConflictPolicy.
+const (
+	IDConflictFail              = "Fail"
+	IDConflictUseExisting       = "UseExisting"
+	IDConflictTerminateExisting = "TerminateExisting"
+)
+
+// StartWorkflowRequest contains parameters for starting a workflow execution.
+// Zero-valued timeouts, policies and delay fall back to server defaults.
+type StartWorkflowRequest struct {
+	WorkflowID       string // Generated when empty
+	WorkflowType     string
+	TaskQueue        string
+	Input            []byte // JSON-encoded workflow input
+	ExecutionTimeout time.Duration
+	RunTimeout       time.Duration
+	TaskTimeout      time.Duration
+	IDReusePolicy    string // One of the IDReuse* constants
+	IDConflictPolicy string // One of the IDConflict* constants
+	Memo             map[string]any
+	SearchAttributes map[string]any
+	StartDelay       time.Duration
+}
+
+// UpdateWorkflowRequest contains parameters for sending an update to a workflow.
+type UpdateWorkflowRequest struct {
+	WorkflowID string
+	RunID      string // Optional; targets the latest run when empty
+	UpdateName string
+	UpdateID   string // Optional; generated when empty
+	Input      []byte // JSON-encoded update arguments
+}
+
+// UpdateResult represents the outcome of a workflow update.
+type UpdateResult struct {
+	UpdateID string
+	RunID    string // Run that received the update
+	Stage    string // Lifecycle stage reached: "Admitted", "Accepted" or "Completed"
+	Result   string // JSON-formatted result
+	Failure  string // Failure message if the update was rejected or failed
+}
@@ -0,0 +1,359 @@
+package temporal
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule overlap policies, as named by the server.
+const (
+	ScheduleOverlapSkip           = "Skip"
+	ScheduleOverlapBufferOne      = "BufferOne"
+	ScheduleOverlapBufferAll      = "BufferAll"
+	ScheduleOverlapCancelOther    = "CancelOther"
+	ScheduleOverlapTerminateOther = "TerminateOther"
+	ScheduleOverlapAllowAll       = "AllowAll"
+)
+
+// ScheduleOverlapPolicies lists the overlap policies in display order, the server default first.
+var ScheduleOverlapPolicies = []string{
+	ScheduleOverlapSkip,
+	ScheduleOverlapBufferOne,
+	ScheduleOverlapBufferAll,
+	ScheduleOverlapCancelOther,
+	ScheduleOverlapTerminateOther,
+	ScheduleOverlapAllowAll,
+}
+
+// Validate checks that a definition has what a schedule needs to run.
+func (d ScheduleDefinition) Validate() error {
+	if d.ID == "" {
+		return fmt.Errorf("schedule ID is required")
+	}
+	if d.Action.WorkflowType == "" || d.Action.TaskQueue == "" {
+		return fmt.Errorf("workflow type and task queue are required")
+	}
+	if len(d.Spec.CronExpressions) == 0 && len(d.Spec.Intervals) == 0 && len(d.Spec.Calendars) == 0 {
+		return fmt.Errorf("a cron expression, interval or calendar is required")
+	}
+	if d.Policy.Overlap != "" && !slices.Contains(ScheduleOverlapPolicies, d.Policy.Overlap) {
+		return fmt.Errorf("unknown overlap policy %q", d.Policy.Overlap)
+	}
+	if d.Spec.StartTime != nil && d.Spec.EndTime != nil && d.Spec.EndTime.Before(*d.Spec.StartTime) {
+		return fmt.Errorf("end time is before start time")
+	}
+	return nil
+}
+
+// ScheduleSpec describes when a schedule runs: any time matched by one of its cron
+// expressions, intervals or calendars, between StartTime and EndTime.
+type ScheduleSpec struct {
+	CronExpressions []string
+	Intervals       []ScheduleInterval
+	Calendars       []ScheduleCalendar
+	Jitter          time.Duration // Each run is delayed by a random amount up to this
+	TimeZone        string        // IANA name, e.g. "America/New_York"; empty for UTC
+	StartTime       *time.Time
+	EndTime         *time.Time
+}
+
+// ScheduleInterval matches times every Every since the epoch, shifted by Offset.
+type ScheduleInterval struct {
+	Every  time.Duration
+	Offset time.Duration
+}
+
+// ScheduleCalendar matches times whose fields all fall in one of the field's ranges.
+// An empty field matches 0 for Second, Minute and Hour, and every value otherwise.
+type ScheduleCalendar struct {
+	Second     []ScheduleRange
+	Minute     []ScheduleRange
+	Hour       []ScheduleRange
+	DayOfMonth []ScheduleRange
+	Month      []ScheduleRange
+	Year       []ScheduleRange
+	DayOfWeek  []ScheduleRange // 0 is Sunday
+	Comment    string
+}
+
+// ScheduleRange matches Start through End, every Step. An End before Start means
+// Start alone, and a Step of 0 means 1.
+type ScheduleRange struct {
+	Start int32
+	End   int32
+	Step  int32
+}
+
+// String formats the spec for display, e.g. "every 1h/15m; dayOfWeek=mon-fri hour=9 (Europe/London)".
+func (s ScheduleSpec) String() string {
+	var parts []string
+	parts = append(parts, s.CronExpressions...)
+	for _, interval := range s.Intervals {
+		parts = append(parts, "every "+interval.String())
+	}
+	for _, cal := range s.Calendars {
+		parts = append(parts, cal.String())
+	}
+
+	if len(parts) == 0 {
+		return "custom"
+	}
+	text := strings.Join(parts, "; ")
+	if s.TimeZone != "" {
+		text += " (" + s.TimeZone + ")"
+	}
+	return text
+}
+
+// String formats the interval as "every" or "every/offset", e.g. "1h" or "1h/15m",
+// the form ParseScheduleInterval reads.
+func (i ScheduleInterval) String() string {
+	if i.Offset == 0 {
+		return FormatScheduleDuration(i.Every)
+	}
+	return FormatScheduleDuration(i.Every) + "/" + FormatScheduleDuration(i.Offset)
+}
+
+// ParseScheduleInterval parses an interval written as "every" or "every/offset".
+func ParseScheduleInterval(text string) (ScheduleInterval, error) {
+	everyText, offsetText, hasOffset := strings.Cut(strings.TrimSpace(text), "/")
+	every, err := time.ParseDuration(strings.TrimSpace(everyText))
+	if err != nil || every <= 0 {
+		return ScheduleInterval{}, fmt.Errorf("invalid interval %q", text)
+	}
+	interval := ScheduleInterval{Every: every}
+	if hasOffset {
+		offset, err := time.ParseDuration(strings.TrimSpace(offsetText))
+		if err != nil || offset < 0 || offset >= every {
+			return ScheduleInterval{}, fmt.Errorf("invalid offset in interval %q: must be less than the interval", text)
+		}
+		interval.Offset = offset
+	}
+	return interval, nil
+}
+
+// FormatScheduleDuration formats a duration without trailing zero units, e.g. "1h" rather than "1h0m0s".
+func FormatScheduleDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
+
+// calendarField describes one field of a calendar in the text form.
+type calendarField struct {
+	name     string
+	min, max int32
+	names    []string // Names for values from min, accepted in place of numbers
+	all      bool     // An empty field matches every value rather than just min
+	get      func(*ScheduleCalendar) *[]ScheduleRange
+}
+
+// calendarFields lists the fields in the order String writes them.
+var calendarFields = []calendarField{
+	{name: "year", min: 1970, max: 2999, all: true, get: func(c *ScheduleCalendar) *[]ScheduleRange { return &c.Year }},
+	{name: "month", min: 1, max: 12, all: true,
+		names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"},
+		get:   func(c *ScheduleCalendar) *[]ScheduleRange { return &c.Month }},
+	{name: "dayOfMonth", min: 1, max: 31, all: true, get: func(c *ScheduleCalendar) *[]ScheduleRange { return &c.DayOfMonth }},
+	{name: "dayOfWeek", min: 0, max: 6, all: true,
+		names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"},
+		get:   func(c *ScheduleCalendar) *[]ScheduleRange { return &c.DayOfWeek }},
+	{name: "hour", min: 0, max: 23, get: func(c *ScheduleCalendar) *[]ScheduleRange { return &c.Hour }},
+	{name: "minute", min: 0, max: 59, get: func(c *ScheduleCalendar) *[]ScheduleRange { return &c.Minute }},
+	{name: "second", min: 0, max: 59, get: func(c *ScheduleCalendar) *[]ScheduleRange { return &c.Second }},
+}
+
+// String formats the calendar as space-separated name=ranges fields, leaving out fields
+// that match their default, followed by "# comment" when there is one. For example
+// "dayOfWeek=mon-fri hour=9 minute=30". ParseScheduleCalendar reads the same form.
+func (c ScheduleCalendar) String() string {
+	var fields []string
+	for _, f := range calendarFields {
+		ranges := *f.get(&c)
+		if f.isDefault(ranges) {
+			continue
+		}
+		fields = append(fields, f.name+"="+f.format(ranges))
+	}
+	if len(fields) == 0 {
+		fields = append(fields, "hour=0") // Every field is at its default: midnight each day
+	}
+	text := strings.Join(fields, " ")
+	if c.Comment != "" {
+		text += " # " + c.Comment
+	}
+	return text
+}
+
+// ParseScheduleCalendar parses a calendar written as space-separated name=ranges
+// fields with an optional "# comment", e.g. "dayOfWeek=mon-fri hour=9,17 # twice a day".
+// Ranges are comma-separated values, start-end spans and "*", each optionally
+// followed by /step. Months and days of the week may be given by name.
+func ParseScheduleCalendar(text string) (ScheduleCalendar, error) {
+	var cal ScheduleCalendar
+	text, comment, _ := strings.Cut(text, "#")
+	cal.Comment = strings.TrimSpace(comment)
+
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return cal, fmt.Errorf("empty calendar")
+	}
+	seen := make(map[string]bool, len(words))
+	for _, word := range words {
+		name, value, ok := strings.Cut(word, "=")
+		if !ok {
+			return cal, fmt.Errorf("expected name=value in calendar, got %q", word)
+		}
+		i := slices.IndexFunc(calendarFields, func(f calendarField) bool {
+			return strings.EqualFold(f.name, name)
+		})
+		if i < 0 {
+			return cal, fmt.Errorf("unknown calendar field %q: expected one of %s", name, calendarFieldNames())
+		}
+		f := calendarFields[i]
+		if seen[f.name] {
+			return cal, fmt.Errorf("calendar field %s is given twice", f.name)
+		}
+		seen[f.name] = true
+
+		ranges, err := f.parse(value)
+		if err != nil {
+			return cal, err
+		}
+		*f.get(&cal) = ranges
+	}
+	return cal, nil
+}
+
+func calendarFieldNames() string {
+	names := make([]string, len(calendarFields))
+	for i, f := range calendarFields {
+		names[i] = f.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// isDefault reports whether ranges match what an empty field would.
+func (f calendarField) isDefault(ranges []ScheduleRange) bool {
+	if len(ranges) == 0 {
+		return true
+	}
+	if len(ranges) > 1 {
+		return false
+	}
+	r := normalizeRange(ranges[0])
+	if f.all {
+		return r.Start <= f.min && r.End >= f.max && r.Step == 1
+	}
+	return r.Start == f.min && r.End == f.min
+}
+
+func (f calendarField) format(ranges []ScheduleRange) string {
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		r = normalizeRange(r)
+		var s string
+		switch {
+		case r.Start == f.min && r.End == f.max:
+			s = "*"
+		case r.End == r.Start:
+			s = f.formatValue(r.Start)
+		default:
+			s = f.formatValue(r.Start) + "-" + f.formatValue(r.End)
+		}
+		if r.Step > 1 {
+			s += "/" + strconv.Itoa(int(r.Step))
+		}
+		parts = append(parts, s)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f calendarField) formatValue(v int32) string {
+	if i := int(v - f.min); i >= 0 && i < len(f.names) {
+		return f.names[i]
+	}
+	return strconv.Itoa(int(v))
+}
+
+func (f calendarField) parse(text string) ([]ScheduleRange, error) {
+	if text == "" {
+		return nil, fmt.Errorf("calendar field %s has no value", f.name)
+	}
+	var ranges []ScheduleRange
+	for _, item := range strings.Split(text, ",") {
+		spanText, stepText, hasStep := strings.Cut(item, "/")
+		var r ScheduleRange
+		switch startText, endText, isSpan := strings.Cut(spanText, "-"); {
+		case spanText == "*":
+			r.Start, r.End = f.min, f.max
+		case isSpan:
+			start, err := f.parseValue(startText)
+			if err != nil {
+				return nil, err
+			}
+			end, err := f.parseValue(endText)
+			if err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("calendar field %s: %s ends before it starts", f.name, spanText)
+			}
+			r.Start, r.End = start, end
+		default:
+			v, err := f.parseValue(spanText)
+			if err != nil {
+				return nil, err
+			}
+			r.Start, r.End = v, v
+			if hasStep {
+				r.End = f.max // "5/15" is every 15 from 5
+			}
+		}
+
+		if hasStep {
+			step, err := strconv.Atoi(stepText)
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("calendar field %s: invalid step %q", f.name, stepText)
+			}
+			r.Step = int32(step)
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges, nil
+}
+
+func (f calendarField) parseValue(text string) (int32, error) {
+	if i := slices.IndexFunc(f.names, func(name string) bool {
+		return strings.HasPrefix(strings.ToLower(text), name)
+	}); i >= 0 && len(text) >= 3 {
+		return f.min + int32(i), nil
+	}
+	n, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("calendar field %s: invalid value %q", f.name, text)
+	}
+	if n < int(f.min) || n > int(f.max) {
+		return 0, fmt.Errorf("calendar field %s: %d is outside %d-%d", f.name, n, f.min, f.max)
+	}
+	return int32(n), nil
+}
+
+// normalizeRange applies the defaults for End and Step.
+func normalizeRange(r ScheduleRange) ScheduleRange {
+	if r.End < r.Start {
+		r.End = r.Start
+	}
+	if r.Step <= 0 {
+		r.Step = 1
+	}
+	return r
+}
@@ -0,0 +1,371 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestFormatScheduleDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{time.Hour, "1h"},
+		{90 * time.Minute, "1h30m"},
+		{15 * time.Minute, "15m"},
+		{24 * time.Hour, "24h"},
+		{90 * time.Second, "1m30s"},
+		{time.Hour + time.Second, "1h0m1s"},
+		{500 * time.Millisecond, "500ms"},
+	}
+	for _, tt := range tests {
+		if got := FormatScheduleDuration(tt.d); got != tt.want {
+			t.Errorf("FormatScheduleDuration(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestParseScheduleInterval(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    ScheduleInterval
+		wantStr string // Empty when parsing fails
+	}{
+		{"1h", ScheduleInterval{Every: time.Hour}, "1h"},
+		{"1h/15m", ScheduleInterval{Every: time.Hour, Offset: 15 * time.Minute}, "1h/15m"},
+		{" 90m / 30m ", ScheduleInterval{Every: 90 * time.Minute, Offset: 30 * time.Minute}, "1h30m/30m"},
+		{"24h/0s", ScheduleInterval{Every: 24 * time.Hour}, "24h"},
+		{"", ScheduleInterval{}, ""},
+		{"hourly", ScheduleInterval{}, ""},
+		{"0s", ScheduleInterval{}, ""},
+		{"-1h", ScheduleInterval{}, ""},
+		{"1h/1h", ScheduleInterval{}, ""},
+		{"1h/-5m", ScheduleInterval{}, ""},
+		{"1h/soon", ScheduleInterval{}, ""},
+	}
+	for _, tt := range tests {
+		got, err := ParseScheduleInterval(tt.text)
+		if tt.wantStr == "" {
+			if err == nil {
+				t.Errorf("ParseScheduleInterval(%q) = %+v, want an error", tt.text, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseScheduleInterval(%q) = %+v, %v; want %+v", tt.text, got, err, tt.want)
+			continue
+		}
+		if s := got.String(); s != tt.wantStr {
+			t.Errorf("ParseScheduleInterval(%q).String() = %q, want %q", tt.text, s, tt.wantStr)
+		}
+		if again, err := ParseScheduleInterval(got.String()); err != nil || again != got {
+			t.Errorf("%q did not parse back: %+v, %v", got.String(), again, err)
+		}
+	}
+}
+
+func TestParseScheduleCalendar(t *testing.T) {
+	tests := []struct {
+		text string
+		want ScheduleCalendar
+		str  string // What String writes back
+	}{
+		{
+			text: "dayOfWeek=mon-fri hour=9 minute=30",
+			want: ScheduleCalendar{
+				DayOfWeek: []ScheduleRange{{Start: 1, End: 5}},
+				Hour:      []ScheduleRange{{Start: 9, End: 9}},
+				Minute:    []ScheduleRange{{Start: 30, End: 30}},
+			},
+			str: "dayOfWeek=mon-fri hour=9 minute=30",
+		},
+		{
+			text: "Hour=9,17 # twice a day",
+			want: ScheduleCalendar{Hour: []ScheduleRange{{Start: 9, End: 9}, {Start: 17, End: 17}}, Comment: "twice a day"},
+			str:  "hour=9,17 # twice a day",
+		},
+		{
+			text: "minute=*/15 hour=*",
+			want: ScheduleCalendar{
+				Minute: []ScheduleRange{{Start: 0, End: 59, Step: 15}},
+				Hour:   []ScheduleRange{{Start: 0, End: 23}},
+			},
+			str: "hour=* minute=*/15",
+		},
+		{
+			text: "minute=5/20",
+			want: ScheduleCalendar{Minute: []ScheduleRange{{Start: 5, End: 59, Step: 20}}},
+			str:  "minute=5-59/20",
+		},
+		{
+			text: "month=January,jul dayOfMonth=1",
+			want: ScheduleCalendar{
+				Month:      []ScheduleRange{{Start: 1, End: 1}, {Start: 7, End: 7}},
+				DayOfMonth: []ScheduleRange{{Start: 1, End: 1}},
+			},
+			str: "month=jan,jul dayOfMonth=1",
+		},
+		{
+			// Fields at their defaults are left out, down to plain midnight
+			text: "dayOfWeek=* hour=0",
+			want: ScheduleCalendar{DayOfWeek: []ScheduleRange{{Start: 0, End: 6}}, Hour: []ScheduleRange{{Start: 0, End: 0}}},
+			str:  "hour=0",
+		},
+		{
+			text: "year=2027 second=30",
+			want: ScheduleCalendar{Year: []ScheduleRange{{Start: 2027, End: 2027}}, Second: []ScheduleRange{{Start: 30, End: 30}}},
+			str:  "year=2027 second=30",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := ParseScheduleCalendar(tt.text)
+			if err != nil {
+				t.Fatalf("ParseScheduleCalendar: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseScheduleCalendar = %+v, want %+v", got, tt.want)
+			}
+			if s := got.String(); s != tt.str {
+				t.Errorf("String() = %q, want %q", s, tt.str)
+			}
+			again, err := ParseScheduleCalendar(got.String())
+			if err != nil || again.String() != got.String() {
+				t.Errorf("%q did not parse back: %+v, %v", got.String(), again, err)
+			}
+		})
+	}
+}
+
+func TestParseScheduleCalendarErrors(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"# only a comment",
+		"hour",
+		"hour=",
+		"weekday=mon",
+		"hour=9 hour=10",
+		"hour=24",
+		"minute=-1",
+		"dayOfWeek=fri-mon",
+		"month=smarch",
+		"minute=*/0",
+		"minute=*/x",
+	} {
+		if cal, err := ParseScheduleCalendar(text); err == nil {
+			t.Errorf("ParseScheduleCalendar(%q) = %+v, want an error", text, cal)
+		}
+	}
+}
+
+func TestScheduleSpecProtoRoundTrip(t *testing.T) {
+	start := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	tests := []struct {
+		name string
+		spec ScheduleSpec
+		str  string
+	}{
+		{
+			name: "cron",
+			spec: ScheduleSpec{CronExpressions: []string{"0 9 * * MON-FRI", "@daily"}},
+			str:  "0 9 * * MON-FRI; @daily",
+		},
+		{
+			name: "interval",
+			spec: ScheduleSpec{Intervals: []ScheduleInterval{{Every: time.Hour, Offset: 15 * time.Minute}}, Jitter: time.Minute},
+			str:  "every 1h/15m",
+		},
+		{
+			name: "calendar",
+			spec: ScheduleSpec{
+				Calendars: []ScheduleCalendar{{
+					DayOfWeek: []ScheduleRange{{Start: 1, End: 5}},
+					Hour:      []ScheduleRange{{Start: 9, End: 9}},
+					Comment:   "weekday mornings",
+				}},
+				TimeZone: "Europe/London",
+			},
+			str: "dayOfWeek=mon-fri hour=9 # weekday mornings (Europe/London)",
+		},
+		{
+			name: "everything",
+			spec: ScheduleSpec{
+				CronExpressions: []string{"*/5 * * * *"},
+				Intervals:       []ScheduleInterval{{Every: 24 * time.Hour}},
+				Calendars:       []ScheduleCalendar{{Minute: []ScheduleRange{{Start: 0, End: 59, Step: 30}}}},
+				StartTime:       &start,
+				EndTime:         &end,
+			},
+			str: "*/5 * * * *; every 24h; minute=*/30",
+		},
+		{
+			name: "empty",
+			str:  "custom",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scheduleSpecFromProto(scheduleSpecToProto(tt.spec))
+			if err != nil {
+				t.Fatalf("scheduleSpecFromProto: %v", err)
+			}
+			if !timesEqual(got.StartTime, tt.spec.StartTime) || !timesEqual(got.EndTime, tt.spec.EndTime) {
+				t.Errorf("times = %v-%v, want %v-%v", got.StartTime, got.EndTime, tt.spec.StartTime, tt.spec.EndTime)
+			}
+			got.StartTime, got.EndTime = tt.spec.StartTime, tt.spec.EndTime
+			if !reflect.DeepEqual(got, tt.spec) {
+				t.Errorf("round trip = %+v, want %+v", got, tt.spec)
+			}
+			if s := got.String(); s != tt.str {
+				t.Errorf("String() = %q, want %q", s, tt.str)
+			}
+		})
+	}
+}
+
+func timesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func TestScheduleSpecFromProtoStringCalendars(t *testing.T) {
+	spec, err := scheduleSpecFromProto(&schedulepb.ScheduleSpec{Calendar: []*schedulepb.CalendarSpec{
+		{DayOfWeek: "mon-fri", Hour: "9", Comment: "weekday mornings"},
+		{},
+	}})
+	if err != nil {
+		t.Fatalf("scheduleSpecFromProto: %v", err)
+	}
+	if got, want := spec.String(), "dayOfWeek=mon-fri hour=9 # weekday mornings; hour=0"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	spec, err = scheduleSpecFromProto(&schedulepb.ScheduleSpec{
+		Calendar:   []*schedulepb.CalendarSpec{{Hour: "noon"}},
+		CronString: []string{"@hourly"},
+	})
+	if err == nil {
+		t.Fatal("scheduleSpecFromProto read a calendar it can't represent")
+	}
+	if !reflect.DeepEqual(spec.CronExpressions, []string{"@hourly"}) || len(spec.Calendars) != 0 {
+		t.Errorf("spec = %+v, want the readable parts without the calendar", spec)
+	}
+}
+
+// fakeScheduleService serves one schedule and records updates to it.
+type fakeScheduleService struct {
+	workflowservice.WorkflowServiceClient
+	schedule    *schedulepb.Schedule
+	rawDescribe bool
+	updated     *workflowservice.UpdateScheduleRequest
+}
+
+func (f *fakeScheduleService) DescribeSchedule(ctx context.Context, req *workflowservice.DescribeScheduleRequest, _ ...grpc.CallOption) (*workflowservice.DescribeScheduleResponse, error) {
+	f.rawDescribe = rawPayloads(ctx)
+	return &workflowservice.DescribeScheduleResponse{Schedule: f.schedule, ConflictToken: []byte("token-1")}, nil
+}
+
+func (f *fakeScheduleService) UpdateSchedule(ctx context.Context, req *workflowservice.UpdateScheduleRequest, _ ...grpc.CallOption) (*workflowservice.UpdateScheduleResponse, error) {
+	f.updated = req
+	return &workflowservice.UpdateScheduleResponse{}, nil
+}
+
+// fakeClient is an SDK client whose WorkflowService is service.
+type fakeClient struct {
+	client.Client
+	service workflowservice.WorkflowServiceClient
+}
+
+func (f fakeClient) WorkflowService() workflowservice.WorkflowServiceClient {
+	return f.service
+}
+
+func newScheduleClient(spec *schedulepb.ScheduleSpec) (*Client, *fakeScheduleService) {
+	service := &fakeScheduleService{schedule: &schedulepb.Schedule{
+		Spec: spec,
+		Action: &schedulepb.ScheduleAction{
+			Action: &schedulepb.ScheduleAction_StartWorkflow{StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{
+				WorkflowId:   "nightly",
+				WorkflowType: &commonpb.WorkflowType{Name: "ReportWorkflow"},
+				TaskQueue:    &taskqueuepb.TaskQueue{Name: "reports"},
+			}},
+		},
+	}}
+	return &Client{client: fakeClient{service: service}}, service
+}
+
+func TestScheduleEdit(t *testing.T) {
+	ctx := context.Background()
+	c, service := newScheduleClient(&schedulepb.ScheduleSpec{Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(24 * time.Hour)}}})
+
+	schedule, err := c.GetSchedule(ctx, "default", "nightly")
+	if err != nil {
+		t.Fatalf("GetSchedule: %v", err)
+	}
+	if schedule.Definition == nil {
+		t.Fatal("GetSchedule left out the definition of a readable schedule")
+	}
+	def := *schedule.Definition
+	def.Spec.Intervals[0].Every = 12 * time.Hour
+	if err := c.UpdateSchedule(ctx, "default", def); err != nil {
+		t.Fatalf("UpdateSchedule: %v", err)
+	}
+	if !service.rawDescribe {
+		t.Error("UpdateSchedule described the schedule with decoded payloads")
+	}
+	if got := service.updated.GetSchedule().GetSpec().GetInterval()[0].GetInterval().AsDuration(); got != 12*time.Hour {
+		t.Errorf("updated interval = %v, want 12h", got)
+	}
+	if string(service.updated.GetConflictToken()) != "token-1" {
+		t.Errorf("conflict token = %q, want token-1", service.updated.GetConflictToken())
+	}
+}
+
+func TestScheduleEditUnreadableCalendar(t *testing.T) {
+	ctx := context.Background()
+	c, service := newScheduleClient(&schedulepb.ScheduleSpec{Calendar: []*schedulepb.CalendarSpec{{Hour: "noon"}}})
+
+	schedule, err := c.GetSchedule(ctx, "default", "nightly")
+	if err != nil {
+		t.Fatalf("GetSchedule: %v", err)
+	}
+	if schedule.Definition != nil {
+		t.Error("GetSchedule offered an unreadable schedule for editing")
+	}
+	if schedule.WorkflowType != "ReportWorkflow" {
+		t.Errorf("WorkflowType = %q, want the readable parts still shown", schedule.WorkflowType)
+	}
+
+	// A definition from before the calendar was added must not overwrite it either
+	def := ScheduleDefinition{
+		ID:     "nightly",
+		Spec:   ScheduleSpec{CronExpressions: []string{"@daily"}},
+		Action: ScheduleAction{WorkflowType: "ReportWorkflow", TaskQueue: "reports"},
+	}
+	err = c.UpdateSchedule(ctx, "default", def)
+	if err == nil {
+		t.Fatal("UpdateSchedule overwrote a calendar it couldn't read")
+	}
+	if service.updated != nil {
+		t.Errorf("an update was sent: %v", service.updated)
+	}
+	if want := fmt.Sprintf("calendar %q is not supported", "hour=noon"); !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %q, want it to mention %q", err, want)
+	}
+}
@@ -0,0 +1,192 @@
+package temporal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/enums/v1"
+)
+
+// Search attribute types, as named by the server.
+const (
+	SearchAttributeKeyword     = "Keyword"
+	SearchAttributeText        = "Text"
+	SearchAttributeInt         = "Int"
+	SearchAttributeDouble      = "Double"
+	SearchAttributeBool        = "Bool"
+	SearchAttributeDatetime    = "Datetime"
+	SearchAttributeKeywordList = "KeywordList"
+)
+
+// SearchAttributeTypes lists the types a custom search attribute can be registered with.
+var SearchAttributeTypes = []string{
+	SearchAttributeKeyword,
+	SearchAttributeText,
+	SearchAttributeInt,
+	SearchAttributeDouble,
+	SearchAttributeBool,
+	SearchAttributeDatetime,
+	SearchAttributeKeywordList,
+}
+
+// SearchAttributeInfo describes a search attribute registered on a namespace.
+type SearchAttributeInfo struct {
+	Name   string
+	Type   string // One of the SearchAttribute* types
+	System bool   // Built into the server; system attributes can't be removed
+}
+
+// newSearchAttributeInfos merges the system and custom attributes of a namespace, sorted by name.
+func newSearchAttributeInfos(system, custom map[string]enums.IndexedValueType) []SearchAttributeInfo {
+	infos := make([]SearchAttributeInfo, 0, len(system)+len(custom))
+	for name, t := range system {
+		infos = append(infos, SearchAttributeInfo{Name: name, Type: t.String(), System: true})
+	}
+	for name, t := range custom {
+		infos = append(infos, SearchAttributeInfo{Name: name, Type: t.String()})
+	}
+	slices.SortFunc(infos, func(a, b SearchAttributeInfo) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return infos
+}
+
+// parseSearchAttributeType converts one of the SearchAttribute* types to its enum.
+func parseSearchAttributeType(typ string) (enums.IndexedValueType, error) {
+	t, err := enums.IndexedValueTypeFromString(typ)
+	if err != nil || t == enums.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return enums.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("unknown search attribute type %q", typ)
+	}
+	return t, nil
+}
+
+// SearchAttribute is a search attribute value decoded using its type metadata.
+type SearchAttribute struct {
+	Type  string // One of the SearchAttribute* types; empty when the server sent none
+	Value any    // string, int64, float64, bool, time.Time or []string, depending on Type
+}
+
+// String formats the value for display.
+func (sa SearchAttribute) String() string {
+	switch v := sa.Value.(type) {
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []string:
+		return strings.Join(v, ", ")
+	case []any:
+		parts := make([]string, len(v))
+		for i, p := range v {
+			parts[i] = fmt.Sprint(p)
+		}
+		return strings.Join(parts, ", ")
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// decodeSearchAttributes decodes indexed fields by the type recorded in their metadata.
+func decodeSearchAttributes(sa *commonpb.SearchAttributes) map[string]SearchAttribute {
+	fields := sa.GetIndexedFields()
+	if len(fields) == 0 {
+		return nil
+	}
+	attrs := make(map[string]SearchAttribute, len(fields))
+	for name, payload := range fields {
+		if payload == nil {
+			continue
+		}
+		attrs[name] = decodeSearchAttribute(payload)
+	}
+	return attrs
+}
+
+func decodeSearchAttribute(payload *commonpb.Payload) SearchAttribute {
+	data := payload.GetData()
+	var attr SearchAttribute
+	if t, err := enums.IndexedValueTypeFromString(string(payload.GetMetadata()["type"])); err == nil && t != enums.INDEXED_VALUE_TYPE_UNSPECIFIED {
+		attr.Type = t.String()
+	}
+
+	switch attr.Type {
+	case SearchAttributeKeyword, SearchAttributeText:
+		var s string
+		if json.Unmarshal(data, &s) == nil {
+			attr.Value = s
+			return attr
+		}
+	case SearchAttributeInt:
+		var n int64
+		if json.Unmarshal(data, &n) == nil {
+			attr.Value = n
+			return attr
+		}
+	case SearchAttributeDouble:
+		var f float64
+		if json.Unmarshal(data, &f) == nil {
+			attr.Value = f
+			return attr
+		}
+	case SearchAttributeBool:
+		var b bool
+		if json.Unmarshal(data, &b) == nil {
+			attr.Value = b
+			return attr
+		}
+	case SearchAttributeDatetime:
+		var t time.Time
+		if json.Unmarshal(data, &t) == nil {
+			attr.Value = t
+			return attr
+		}
+	case SearchAttributeKeywordList:
+		var list []string
+		if json.Unmarshal(data, &list) == nil {
+			attr.Value = list
+			return attr
+		}
+		// A single keyword may be stored without the list around it
+		var s string
+		if json.Unmarshal(data, &s) == nil {
+			attr.Value = []string{s}
+			return attr
+		}
+	}
+
+	// Unknown type or data that doesn't match it; show whatever the JSON holds
+	var v any
+	if json.Unmarshal(data, &v) == nil {
+		attr.Value = v
+	} else {
+		attr.Value = string(data)
+	}
+	return attr
+}
+
+// decodeMemo returns each memo field as its full JSON value.
+func decodeMemo(memo *commonpb.Memo) map[string]string {
+	fields := memo.GetFields()
+	if len(fields) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(fields))
+	for name, payload := range fields {
+		if payload == nil {
+			continue
+		}
+		var compact bytes.Buffer
+		if json.Compact(&compact, payload.GetData()) == nil {
+			values[name] = compact.String()
+			continue
+		}
+		values[name] = formatPayloads(&commonpb.Payloads{Payloads: []*commonpb.Payload{payload}})
+	}
+	return values
+}
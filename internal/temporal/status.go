@@ -14,6 +14,8 @@ const (
 	StatusTerminated = "Terminated"
 	StatusTimedOut   = "TimedOut"
 	StatusUnknown    = "Unknown"
+
+	StatusContinuedAsNew = "ContinuedAsNew"
 )
 
 // MapWorkflowStatus converts a Temporal SDK workflow execution status to a UI-friendly string.
@@ -32,12 +34,19 @@ func MapWorkflowStatus(status enums.WorkflowExecutionStatus) string {
 	case enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
 		return StatusTimedOut
 	case enums.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
-		return StatusCompleted // Treat ContinuedAsNew as completed for display
+		return StatusContinuedAsNew
 	default:
 		return StatusUnknown
 	}
 }
 
+// Workflow update lifecycle constants, used as event tree statuses.
+const (
+	UpdateStatusAdmitted = "Admitted"
+	UpdateStatusAccepted = "Accepted"
+	UpdateStatusRejected = "Rejected"
+)
+
 // NamespaceState constants.
 const (
 	NamespaceStateActive     = "Active"
@@ -88,8 +97,14 @@ func RegisterTemporalStatuses() {
 	theme.RegisterStatusDynamic(StatusCanceled, theme.Warning, theme.IconCanceled)
 	theme.RegisterStatusDynamic(StatusTerminated, theme.Error, theme.IconStop)
 	theme.RegisterStatusDynamic(StatusTimedOut, theme.Warning, theme.IconTimedOut)
+	theme.RegisterStatusDynamic(StatusContinuedAsNew, theme.Success, theme.IconArrowRight)
 	theme.RegisterStatusDynamic(StatusUnknown, theme.FgDim, theme.IconPending)
 
+	// Workflow update statuses
+	theme.RegisterStatusDynamic(UpdateStatusAdmitted, theme.FgDim, theme.IconPending)
+	theme.RegisterStatusDynamic(UpdateStatusAccepted, theme.Info, theme.IconRunning)
+	theme.RegisterStatusDynamic(UpdateStatusRejected, theme.Error, theme.IconFailed)
+
 	// Namespace states
 	theme.RegisterStatusDynamic(NamespaceStateActive, theme.Success, theme.IconCheck)
 	theme.RegisterStatusDynamic(NamespaceStateDeprecated, theme.Warning, theme.IconWarning)
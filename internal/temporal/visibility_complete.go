@@ -0,0 +1,325 @@
+package temporal
+
+import (
+	"slices"
+	"strings"
+
+	"go.temporal.io/api/enums/v1"
+)
+
+// VisibilityCompletion is a suggestion for the word at the cursor of a visibility query.
+type VisibilityCompletion struct {
+	Text     string // Replaces the query from the start returned with it up to the cursor
+	Category string // "Attribute", "Operator", "Value" or "Keyword"
+	Detail   string // Attribute type or what a value means
+}
+
+// completionSlot is what the grammar expects at the cursor.
+type completionSlot int
+
+const (
+	slotNone completionSlot = iota
+	slotAttribute
+	slotOperator
+	slotNotOperator // After NOT: IN, BETWEEN or STARTS_WITH
+	slotNull        // After IS: NULL or NOT NULL
+	slotNotNull     // After IS NOT: NULL
+	slotValue
+	slotConnector // After a complete condition: AND, OR, ORDER BY
+	slotBetweenAnd
+	slotBy
+	slotOrderAttribute
+	slotOrderDirection
+)
+
+// CompleteVisibilityQuery suggests what can follow query[:cursor]: attribute names,
+// operators suited to the attribute's type, values for ExecutionStatus, Bool and
+// Datetime attributes (quoted when the type needs it) and keywords. knownValues
+// adds values seen for Keyword attributes, such as workflow types.
+// start is the byte offset of the partial word each completion replaces.
+func CompleteVisibilityQuery(query string, cursor int, attrs []SearchAttributeInfo, knownValues map[string][]string) (start int, completions []VisibilityCompletion) {
+	if cursor < 0 || cursor > len(query) {
+		cursor = len(query)
+	}
+	prefix := query[:cursor]
+	tokens, err := lexVisibilityQuery(prefix, true)
+	if err != nil {
+		return cursor, nil
+	}
+
+	// The token the cursor is touching is the partial word being completed
+	start = cursor
+	partial := ""
+	if n := len(tokens); n > 0 && tokens[n-1].end == cursor {
+		last := tokens[n-1]
+		switch {
+		case last.kind == tokenString && !last.unfinished:
+			return cursor, nil // A finished value; wait for a space
+		case last.kind == tokenRParen, last.kind == tokenComma, last.kind == tokenLParen, last.kind == tokenOperator:
+			// Punctuation ends a word, so the slot after it starts here
+		default:
+			start = last.start
+			partial = prefix[last.start:cursor]
+			tokens = tokens[:n-1]
+		}
+	}
+
+	slot, attr := completionContext(tokens)
+	types := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		types[a.Name] = a.Type
+	}
+
+	var candidates []VisibilityCompletion
+	keywords := func(words ...string) {
+		for _, w := range words {
+			candidates = append(candidates, VisibilityCompletion{Text: w + " ", Category: "Keyword"})
+		}
+	}
+
+	switch slot {
+	case slotAttribute, slotOrderAttribute:
+		for _, a := range attrs {
+			candidates = append(candidates, VisibilityCompletion{Text: a.Name + " ", Category: "Attribute", Detail: a.Type})
+		}
+	case slotOperator:
+		candidates = operatorCompletions(types[attr])
+	case slotNotOperator:
+		keywords("IN", "BETWEEN")
+		if typ := types[attr]; typ == "" || typ == SearchAttributeKeyword || typ == SearchAttributeKeywordList {
+			keywords("STARTS_WITH")
+		}
+	case slotNull:
+		keywords("NULL", "NOT NULL")
+	case slotNotNull:
+		keywords("NULL")
+	case slotValue:
+		candidates = valueCompletions(attr, types[attr], knownValues[attr])
+	case slotBetweenAnd:
+		keywords("AND")
+	case slotBy:
+		keywords("BY")
+	case slotConnector:
+		if partial == "" {
+			return start, nil // The query may be complete; leave Enter to run it
+		}
+		keywords("AND", "OR", "ORDER BY")
+	case slotOrderDirection:
+		if partial == "" {
+			return start, nil
+		}
+		keywords("ASC", "DESC")
+	}
+
+	// Match the partial word case-insensitively, ignoring an opening quote
+	match := strings.ToLower(strings.TrimLeft(partial, "'\"`"))
+	for _, c := range candidates {
+		text := strings.TrimSpace(c.Text)
+		if text == partial {
+			continue // Already typed out
+		}
+		if strings.HasPrefix(strings.ToLower(strings.TrimLeft(text, "'\"")), match) {
+			completions = append(completions, c)
+		}
+	}
+	return start, completions
+}
+
+// completionContext walks the tokens before the cursor and returns what the grammar
+// expects next, along with the attribute of the condition being written.
+func completionContext(tokens []token) (completionSlot, string) {
+	slot := slotAttribute
+	attr := ""
+	inList := false
+	betweenValues := 0
+
+	for _, t := range tokens {
+		isKeyword := func(words ...string) bool {
+			return t.kind == tokenKeyword && slices.Contains(words, t.text)
+		}
+
+		switch slot {
+		case slotAttribute:
+			switch {
+			case t.kind == tokenLParen:
+			case isKeyword("ORDER"):
+				slot = slotBy
+			case t.kind == tokenIdent:
+				attr = t.text
+				slot = slotOperator
+			default:
+				return slotNone, ""
+			}
+
+		case slotOperator:
+			switch {
+			case t.kind == tokenOperator, isKeyword("STARTS_WITH"):
+				slot = slotValue
+			case isKeyword("IS"):
+				slot = slotNull
+			case isKeyword("NOT"):
+				slot = slotNotOperator
+			case isKeyword("IN"):
+				slot, inList = slotNone, true
+			case isKeyword("BETWEEN"):
+				slot, betweenValues = slotValue, 2
+			default:
+				return slotNone, ""
+			}
+
+		case slotNotOperator:
+			switch {
+			case isKeyword("STARTS_WITH"):
+				slot = slotValue
+			case isKeyword("IN"):
+				slot, inList = slotNone, true
+			case isKeyword("BETWEEN"):
+				slot, betweenValues = slotValue, 2
+			default:
+				return slotNone, ""
+			}
+
+		case slotNull, slotNotNull:
+			switch {
+			case isKeyword("NOT") && slot == slotNull:
+				slot = slotNotNull
+			case isKeyword("NULL"):
+				slot = slotConnector
+			default:
+				return slotNone, ""
+			}
+
+		case slotNone:
+			// Only reached inside an IN list, waiting for its opening parenthesis or a separator
+			switch {
+			case !inList:
+				return slotNone, ""
+			case t.kind == tokenLParen, t.kind == tokenComma:
+				slot = slotValue
+			case t.kind == tokenRParen:
+				slot, inList = slotConnector, false
+			default:
+				return slotNone, ""
+			}
+
+		case slotValue:
+			if t.kind != tokenString && t.kind != tokenNumber && t.kind != tokenPlaceholder && t.kind != tokenIdent {
+				return slotNone, ""
+			}
+			switch {
+			case inList:
+				slot = slotNone
+			case betweenValues == 2:
+				slot, betweenValues = slotBetweenAnd, 1
+			default:
+				slot, betweenValues = slotConnector, 0
+			}
+
+		case slotBetweenAnd:
+			if !isKeyword("AND") {
+				return slotNone, ""
+			}
+			slot = slotValue
+
+		case slotConnector:
+			switch {
+			case t.kind == tokenRParen:
+			case isKeyword("AND", "OR"):
+				slot, attr = slotAttribute, ""
+			case isKeyword("ORDER"):
+				slot = slotBy
+			default:
+				return slotNone, ""
+			}
+
+		case slotBy:
+			if !isKeyword("BY") {
+				return slotNone, ""
+			}
+			slot = slotOrderAttribute
+
+		case slotOrderAttribute:
+			if t.kind != tokenIdent {
+				return slotNone, ""
+			}
+			slot = slotOrderDirection
+
+		case slotOrderDirection:
+			switch {
+			case isKeyword("ASC", "DESC"):
+			case t.kind == tokenComma:
+				slot = slotOrderAttribute
+			default:
+				return slotNone, ""
+			}
+		}
+	}
+	return slot, attr
+}
+
+func operatorCompletions(typ string) []VisibilityCompletion {
+	ops := []string{"=", "!="}
+	if typ != SearchAttributeBool {
+		ops = append(ops, "<", "<=", ">", ">=")
+	}
+	var completions []VisibilityCompletion
+	for _, op := range ops {
+		completions = append(completions, VisibilityCompletion{Text: op + " ", Category: "Operator"})
+	}
+
+	words := []string{"IN", "NOT IN"}
+	if typ != SearchAttributeBool {
+		words = append(words, "BETWEEN")
+	}
+	if typ == "" || typ == SearchAttributeKeyword || typ == SearchAttributeKeywordList {
+		words = append(words, "STARTS_WITH")
+	}
+	words = append(words, "IS NULL", "IS NOT NULL")
+	for _, w := range words {
+		completions = append(completions, VisibilityCompletion{Text: w + " ", Category: "Operator"})
+	}
+	return completions
+}
+
+func valueCompletions(attr, typ string, known []string) []VisibilityCompletion {
+	var completions []VisibilityCompletion
+	switch {
+	case attr == "ExecutionStatus":
+		for _, status := range executionStatuses() {
+			completions = append(completions, VisibilityCompletion{Text: QuoteVisibilityValue(status) + " ", Category: "Value"})
+		}
+	case typ == SearchAttributeBool:
+		for _, b := range []string{"true", "false"} {
+			completions = append(completions, VisibilityCompletion{Text: b + " ", Category: "Value"})
+		}
+	case typ == SearchAttributeDatetime:
+		for _, p := range visibilityPlaceholders {
+			completions = append(completions, VisibilityCompletion{Text: p.name + " ", Category: "Value", Detail: p.description})
+		}
+	case typ == SearchAttributeKeyword || typ == SearchAttributeText || typ == SearchAttributeKeywordList:
+		for _, v := range known {
+			completions = append(completions, VisibilityCompletion{Text: QuoteVisibilityValue(v) + " ", Category: "Value"})
+		}
+	}
+	return completions
+}
+
+// executionStatuses returns the ExecutionStatus values a query can filter on.
+func executionStatuses() []string {
+	var statuses []string
+	for s := enums.WORKFLOW_EXECUTION_STATUS_RUNNING; ; s++ {
+		if _, ok := enums.WorkflowExecutionStatus_name[int32(s)]; !ok {
+			return statuses
+		}
+		statuses = append(statuses, s.String())
+	}
+}
+
+// visibilityQuoter escapes backslashes, which the server reads as escapes, and quotes.
+var visibilityQuoter = strings.NewReplacer(`\`, `\\`, "'", "''")
+
+// QuoteVisibilityValue single-quotes a string value for a visibility query, escaping
+// any quotes and backslashes inside it.
+func QuoteVisibilityValue(s string) string {
+	return "'" + visibilityQuoter.Replace(s) + "'"
+}
@@ -0,0 +1,635 @@
+package temporal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/enums/v1"
+)
+
+// VisibilityQueryError reports where a visibility query is invalid.
+type VisibilityQueryError struct {
+	Start   int // Byte offset of the offending text
+	End     int // Byte offset just past it
+	Message string
+}
+
+func (e *VisibilityQueryError) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Message, e.Start+1)
+}
+
+// VisibilityQuery is a parsed visibility query. The AND/OR structure isn't kept;
+// each predicate is checked on its own.
+type VisibilityQuery struct {
+	Conditions []VisibilityCondition
+	OrderBy    []VisibilityOrder
+}
+
+// VisibilityCondition is a single predicate, such as `WorkflowType = 'OrderWorkflow'`.
+type VisibilityCondition struct {
+	Attribute string
+	Operator  string // "=", "!=", "<", "<=", ">", ">=", "IN", "NOT IN", "BETWEEN", "NOT BETWEEN", "STARTS_WITH", "NOT STARTS_WITH", "IS NULL" or "IS NOT NULL"
+	Values    []VisibilityValue
+	Start     int
+	End       int
+}
+
+// VisibilityValue is a literal in a condition.
+type VisibilityValue struct {
+	Kind  VisibilityValueKind
+	Value string // Unquoted string, number, "true"/"false" or placeholder name
+	Start int
+	End   int
+}
+
+// VisibilityValueKind is the kind of literal a value was written as.
+type VisibilityValueKind int
+
+// Visibility value kinds.
+const (
+	VisibilityString      VisibilityValueKind = iota // 'quoted' or "quoted"
+	VisibilityNumber                                 // 42, -1.5
+	VisibilityBool                                   // true, false
+	VisibilityPlaceholder                            // $TODAY and friends, resolved to a time before sending
+)
+
+// VisibilityOrder is one ORDER BY term.
+type VisibilityOrder struct {
+	Attribute  string
+	Descending bool
+	Start      int
+	End        int
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenKeyword
+	tokenString
+	tokenNumber
+	tokenPlaceholder
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind       tokenKind
+	text       string // Keywords upper-cased, strings unquoted, everything else as written
+	start      int
+	end        int
+	unfinished bool // String missing its closing quote
+}
+
+var visibilityKeywords = map[string]bool{
+	"AND": true, "OR": true, "NOT": true, "IN": true, "BETWEEN": true, "STARTS_WITH": true,
+	"IS": true, "NULL": true, "ORDER": true, "BY": true, "ASC": true, "DESC": true,
+}
+
+// lexVisibilityQuery splits a query into tokens. With partial set, a string missing
+// its closing quote is returned as an unfinished token rather than an error, so
+// a query can be completed while it is being typed.
+func lexVisibilityQuery(query string, partial bool) ([]token, error) {
+	var tokens []token
+	i := 0
+	for i < len(query) {
+		c := query[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", start: i, end: i + 1})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", start: i, end: i + 1})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", start: i, end: i + 1})
+			i++
+
+		case c == '=' || c == '<' || c == '>' || c == '!':
+			end := i + 1
+			if end < len(query) && (query[end] == '=' || (c == '<' && query[end] == '>')) {
+				end++
+			}
+			op := query[i:end]
+			if op == "!" {
+				return tokens, &VisibilityQueryError{Start: i, End: end, Message: "expected != after !"}
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, start: i, end: end})
+			i = end
+
+		case c == '\'' || c == '"':
+			var b strings.Builder
+			end := i + 1
+			closed := false
+			for end < len(query) {
+				ch := query[end]
+				if ch == '\\' && end+1 < len(query) {
+					b.WriteByte(query[end+1])
+					end += 2
+					continue
+				}
+				if ch == c {
+					// A doubled quote is an escaped quote
+					if end+1 < len(query) && query[end+1] == c {
+						b.WriteByte(c)
+						end += 2
+						continue
+					}
+					closed = true
+					end++
+					break
+				}
+				b.WriteByte(ch)
+				end++
+			}
+			if !closed && !partial {
+				return tokens, &VisibilityQueryError{Start: i, End: end, Message: "unterminated string"}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: b.String(), start: i, end: end, unfinished: !closed})
+			i = end
+
+		case c == '`':
+			end := strings.IndexByte(query[i+1:], '`')
+			if end < 0 {
+				if !partial {
+					return tokens, &VisibilityQueryError{Start: i, End: len(query), Message: "unterminated `quoted` name"}
+				}
+				tokens = append(tokens, token{kind: tokenIdent, text: query[i+1:], start: i, end: len(query), unfinished: true})
+				i = len(query)
+				continue
+			}
+			end += i + 1
+			tokens = append(tokens, token{kind: tokenIdent, text: query[i+1 : end], start: i, end: end + 1})
+			i = end + 1
+
+		case c == '$':
+			end := i + 1
+			for end < len(query) && isIdentChar(query[end]) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenPlaceholder, text: query[i:end], start: i, end: end})
+			i = end
+
+		case isDigit(c) || ((c == '-' || c == '.') && i+1 < len(query) && isDigit(query[i+1])):
+			end := i + 1
+			for end < len(query) && (isDigit(query[end]) || query[end] == '.' || query[end] == 'e' || query[end] == 'E' ||
+				((query[end] == '-' || query[end] == '+') && (query[end-1] == 'e' || query[end-1] == 'E'))) {
+				end++
+			}
+			if _, err := strconv.ParseFloat(query[i:end], 64); err != nil {
+				return tokens, &VisibilityQueryError{Start: i, End: end, Message: fmt.Sprintf("invalid number %s", query[i:end])}
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: query[i:end], start: i, end: end})
+			i = end
+
+		case isIdentChar(c):
+			end := i + 1
+			for end < len(query) && isIdentChar(query[end]) {
+				end++
+			}
+			word := query[i:end]
+			if upper := strings.ToUpper(word); visibilityKeywords[upper] {
+				tokens = append(tokens, token{kind: tokenKeyword, text: upper, start: i, end: end})
+			} else {
+				tokens = append(tokens, token{kind: tokenIdent, text: word, start: i, end: end})
+			}
+			i = end
+
+		default:
+			return tokens, &VisibilityQueryError{Start: i, End: i + 1, Message: fmt.Sprintf("unexpected character %q", c)}
+		}
+	}
+	return tokens, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || isDigit(c) || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// ParseVisibilityQuery parses a query in Temporal's SQL-like visibility syntax:
+// comparisons, IN, BETWEEN, STARTS_WITH and IS NULL joined with AND/OR and
+// parentheses, optionally followed by ORDER BY. Errors are *VisibilityQueryError.
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	tokens, err := lexVisibilityQuery(query, false)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens, length: len(query), query: &VisibilityQuery{}}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.query, nil
+}
+
+type queryParser struct {
+	tokens []token
+	pos    int
+	length int
+	query  *VisibilityQuery
+}
+
+func (p *queryParser) peek() token {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return token{kind: tokenEOF, start: p.length, end: p.length}
+}
+
+func (p *queryParser) next() token {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return t
+}
+
+func (p *queryParser) isKeyword(word string) bool {
+	t := p.peek()
+	return t.kind == tokenKeyword && t.text == word
+}
+
+func (p *queryParser) expectKeyword(word string) error {
+	if !p.isKeyword(word) {
+		return p.errorf(p.peek(), "expected %s", word)
+	}
+	p.next()
+	return nil
+}
+
+func (p *queryParser) errorf(t token, format string, args ...any) error {
+	msg := fmt.Sprintf(format, args...)
+	if t.kind == tokenEOF {
+		msg += " but the query ended"
+	} else {
+		msg += fmt.Sprintf(", found %s", describeToken(t))
+	}
+	end := t.end
+	if end == t.start {
+		end = t.start + 1
+	}
+	return &VisibilityQueryError{Start: t.start, End: end, Message: msg}
+}
+
+func describeToken(t token) string {
+	if t.kind == tokenString {
+		return "'" + t.text + "'"
+	}
+	return t.text
+}
+
+func (p *queryParser) parse() error {
+	if p.peek().kind != tokenEOF && !p.isKeyword("ORDER") {
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+	}
+	if p.isKeyword("ORDER") {
+		p.next()
+		if err := p.expectKeyword("BY"); err != nil {
+			return err
+		}
+		for {
+			t := p.next()
+			if t.kind != tokenIdent {
+				return p.errorf(t, "expected a search attribute to order by")
+			}
+			order := VisibilityOrder{Attribute: t.text, Start: t.start, End: t.end}
+			if p.isKeyword("ASC") || p.isKeyword("DESC") {
+				dir := p.next()
+				order.Descending = dir.text == "DESC"
+				order.End = dir.end
+			}
+			p.query.OrderBy = append(p.query.OrderBy, order)
+			if p.peek().kind != tokenComma {
+				break
+			}
+			p.next()
+		}
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		if t.kind == tokenRParen {
+			return p.errorf(t, "unbalanced parenthesis")
+		}
+		return p.errorf(t, "expected AND, OR or ORDER BY")
+	}
+	return nil
+}
+
+func (p *queryParser) parseOr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for p.isKeyword("OR") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *queryParser) parseAnd() error {
+	if err := p.parsePrimary(); err != nil {
+		return err
+	}
+	for p.isKeyword("AND") {
+		p.next()
+		if err := p.parsePrimary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *queryParser) parsePrimary() error {
+	if p.peek().kind == tokenLParen {
+		open := p.next()
+		if err := p.parseOr(); err != nil {
+			return err
+		}
+		if p.peek().kind != tokenRParen {
+			if p.peek().kind == tokenEOF {
+				return &VisibilityQueryError{Start: open.start, End: open.end, Message: "unclosed parenthesis"}
+			}
+			return p.errorf(p.peek(), "expected )")
+		}
+		p.next()
+		return nil
+	}
+	return p.parseCondition()
+}
+
+func (p *queryParser) parseCondition() error {
+	attr := p.next()
+	if attr.kind != tokenIdent {
+		return p.errorf(attr, "expected a search attribute")
+	}
+	cond := VisibilityCondition{Attribute: attr.text, Start: attr.start}
+
+	op := p.next()
+	switch {
+	case op.kind == tokenOperator:
+		cond.Operator = op.text
+		if cond.Operator == "<>" {
+			cond.Operator = "!="
+		}
+		v, err := p.parseValue()
+		if err != nil {
+			return err
+		}
+		cond.Values = []VisibilityValue{v}
+
+	case op.kind == tokenKeyword && op.text == "IS":
+		cond.Operator = "IS NULL"
+		if p.isKeyword("NOT") {
+			p.next()
+			cond.Operator = "IS NOT NULL"
+		}
+		if err := p.expectKeyword("NULL"); err != nil {
+			return err
+		}
+
+	case op.kind == tokenKeyword && (op.text == "NOT" || op.text == "IN" || op.text == "BETWEEN" || op.text == "STARTS_WITH"):
+		prefix := ""
+		if op.text == "NOT" {
+			prefix = "NOT "
+			op = p.next()
+			if op.kind != tokenKeyword || (op.text != "IN" && op.text != "BETWEEN" && op.text != "STARTS_WITH") {
+				return p.errorf(op, "expected IN, BETWEEN or STARTS_WITH after NOT")
+			}
+		}
+		cond.Operator = prefix + op.text
+
+		switch op.text {
+		case "IN":
+			if t := p.next(); t.kind != tokenLParen {
+				return p.errorf(t, "expected ( after IN")
+			}
+			for {
+				v, err := p.parseValue()
+				if err != nil {
+					return err
+				}
+				cond.Values = append(cond.Values, v)
+				t := p.next()
+				if t.kind == tokenRParen {
+					break
+				}
+				if t.kind != tokenComma {
+					return p.errorf(t, "expected , or ) in IN list")
+				}
+			}
+		case "BETWEEN":
+			from, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			if err := p.expectKeyword("AND"); err != nil {
+				return err
+			}
+			to, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			cond.Values = []VisibilityValue{from, to}
+		default:
+			v, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			cond.Values = []VisibilityValue{v}
+		}
+
+	default:
+		return p.errorf(op, "expected an operator after %s", attr.text)
+	}
+
+	cond.End = p.tokens[p.pos-1].end
+	p.query.Conditions = append(p.query.Conditions, cond)
+	return nil
+}
+
+func (p *queryParser) parseValue() (VisibilityValue, error) {
+	t := p.next()
+	v := VisibilityValue{Value: t.text, Start: t.start, End: t.end}
+	switch t.kind {
+	case tokenString:
+		v.Kind = VisibilityString
+	case tokenNumber:
+		v.Kind = VisibilityNumber
+	case tokenPlaceholder:
+		v.Kind = VisibilityPlaceholder
+	case tokenIdent:
+		if b := strings.ToLower(t.text); b == "true" || b == "false" {
+			v.Kind = VisibilityBool
+			v.Value = b
+			break
+		}
+		return v, p.errorf(t, "expected a value (strings need quotes)")
+	default:
+		return v, p.errorf(t, "expected a value")
+	}
+	return v, nil
+}
+
+// Validate checks the query against a namespace's search attributes: every
+// attribute must exist, and operators and values must suit its type. With no
+// attributes given, only the built-in ExecutionStatus values are checked.
+func (q *VisibilityQuery) Validate(attrs []SearchAttributeInfo) error {
+	types := make(map[string]string, len(attrs))
+	for _, a := range attrs {
+		types[a.Name] = a.Type
+	}
+
+	for _, cond := range q.Conditions {
+		typ, known := types[cond.Attribute]
+		if !known && len(types) > 0 {
+			return &VisibilityQueryError{Start: cond.Start, End: cond.Start + len(cond.Attribute),
+				Message: fmt.Sprintf("unknown search attribute %s", cond.Attribute)}
+		}
+		if err := checkOperator(cond, typ); err != nil {
+			return err
+		}
+		for _, v := range cond.Values {
+			if err := checkValue(cond.Attribute, typ, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, order := range q.OrderBy {
+		if _, known := types[order.Attribute]; !known && len(types) > 0 {
+			return &VisibilityQueryError{Start: order.Start, End: order.Start + len(order.Attribute),
+				Message: fmt.Sprintf("unknown search attribute %s", order.Attribute)}
+		}
+	}
+	return nil
+}
+
+func checkOperator(cond VisibilityCondition, typ string) error {
+	invalid := func() error {
+		return &VisibilityQueryError{Start: cond.Start, End: cond.End,
+			Message: fmt.Sprintf("%s can't be used with %s attribute %s", cond.Operator, typ, cond.Attribute)}
+	}
+	switch cond.Operator {
+	case "STARTS_WITH", "NOT STARTS_WITH":
+		if typ != "" && typ != SearchAttributeKeyword && typ != SearchAttributeKeywordList {
+			return invalid()
+		}
+	case "<", "<=", ">", ">=", "BETWEEN", "NOT BETWEEN":
+		if typ == SearchAttributeBool {
+			return invalid()
+		}
+	}
+	return nil
+}
+
+func checkValue(attr, typ string, v VisibilityValue) error {
+	invalid := func(format string, args ...any) error {
+		return &VisibilityQueryError{Start: v.Start, End: v.End, Message: fmt.Sprintf(format, args...)}
+	}
+
+	if v.Kind == VisibilityPlaceholder {
+		if typ != "" && typ != SearchAttributeDatetime {
+			return invalid("time placeholder %s used with %s attribute %s", v.Value, typ, attr)
+		}
+		if !isTimePlaceholder(v.Value) {
+			return invalid("unknown placeholder %s", v.Value)
+		}
+		return nil
+	}
+
+	if attr == "ExecutionStatus" {
+		if v.Kind != VisibilityString {
+			return invalid("ExecutionStatus values need quotes, e.g. 'Running'")
+		}
+		if s, err := enums.WorkflowExecutionStatusFromString(v.Value); err != nil || s == enums.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED {
+			return invalid("unknown ExecutionStatus '%s'", v.Value)
+		}
+		return nil
+	}
+
+	switch typ {
+	case SearchAttributeInt:
+		if _, err := strconv.ParseInt(v.Value, 10, 64); err != nil || v.Kind == VisibilityBool {
+			return invalid("%s is an Int attribute; expected a whole number", attr)
+		}
+	case SearchAttributeDouble:
+		if _, err := strconv.ParseFloat(v.Value, 64); err != nil || v.Kind == VisibilityBool {
+			return invalid("%s is a Double attribute; expected a number", attr)
+		}
+	case SearchAttributeBool:
+		if b := strings.ToLower(v.Value); b != "true" && b != "false" {
+			return invalid("%s is a Bool attribute; expected true or false", attr)
+		}
+	case SearchAttributeDatetime:
+		if v.Kind == VisibilityNumber {
+			return nil // Unix nanoseconds
+		}
+		if _, err := time.Parse(time.RFC3339Nano, v.Value); err != nil || v.Kind != VisibilityString {
+			return invalid("%s is a Datetime attribute; expected an RFC 3339 time such as '2024-01-02T15:04:05Z' or a placeholder such as $TODAY", attr)
+		}
+	case SearchAttributeKeyword, SearchAttributeText, SearchAttributeKeywordList:
+		if v.Kind != VisibilityString {
+			return invalid("%s is a %s attribute; quote the value", attr, typ)
+		}
+	}
+	return nil
+}
+
+// visibilityPlaceholders are the time placeholders tempo resolves before sending a query.
+// The _N forms take any number.
+var visibilityPlaceholders = []struct {
+	name, description string
+}{
+	{"$TODAY", "start of today"},
+	{"$YESTERDAY", "start of yesterday"},
+	{"$THIS_WEEK", "start of this week"},
+	{"$HOUR_AGO", "1 hour ago"},
+	{"$HOURS_AGO_6", "N hours ago"},
+	{"$MINUTES_AGO_30", "N minutes ago"},
+	{"$DAYS_AGO_7", "N days ago, at midnight"},
+}
+
+func isTimePlaceholder(name string) bool {
+	switch name {
+	case "$TODAY", "$YESTERDAY", "$THIS_WEEK", "$HOUR_AGO":
+		return true
+	}
+	for _, prefix := range []string{"$HOURS_AGO_", "$MINUTES_AGO_", "$DAYS_AGO_"} {
+		if n, ok := strings.CutPrefix(name, prefix); ok && n != "" {
+			if _, err := strconv.Atoi(n); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withoutOrderBy returns query with any ORDER BY clause removed, for clauses such
+// as GROUP BY that must come before it. Queries that don't lex are returned as is
+// so the server reports the error.
+func withoutOrderBy(query string) string {
+	tokens, err := lexVisibilityQuery(query, false)
+	if err != nil {
+		return query
+	}
+	for i, t := range tokens {
+		if t.kind == tokenKeyword && t.text == "ORDER" && i+1 < len(tokens) && tokens[i+1].text == "BY" {
+			return strings.TrimSpace(query[:t.start])
+		}
+	}
+	return query
+}
@@ -0,0 +1,356 @@
+package temporal
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestParseVisibilityQuery(t *testing.T) {
+	str := func(v string) VisibilityValue { return VisibilityValue{Kind: VisibilityString, Value: v} }
+
+	tests := []struct {
+		name       string
+		query      string
+		conditions []VisibilityCondition // Positions aren't compared
+		orderBy    []VisibilityOrder
+	}{
+		{"empty", "", nil, nil},
+		{
+			"comparison",
+			"WorkflowType = 'OrderWorkflow'",
+			[]VisibilityCondition{{Attribute: "WorkflowType", Operator: "=", Values: []VisibilityValue{str("OrderWorkflow")}}},
+			nil,
+		},
+		{
+			"<> means !=",
+			`TaskQueue <> "orders"`,
+			[]VisibilityCondition{{Attribute: "TaskQueue", Operator: "!=", Values: []VisibilityValue{str("orders")}}},
+			nil,
+		},
+		{
+			"numbers and bools",
+			"Attempts >= -1.5e3 and IsPriority = TRUE",
+			[]VisibilityCondition{
+				{Attribute: "Attempts", Operator: ">=", Values: []VisibilityValue{{Kind: VisibilityNumber, Value: "-1.5e3"}}},
+				{Attribute: "IsPriority", Operator: "=", Values: []VisibilityValue{{Kind: VisibilityBool, Value: "true"}}},
+			},
+			nil,
+		},
+		{
+			"IN",
+			"ExecutionStatus IN ('Running', 'Failed')",
+			[]VisibilityCondition{{Attribute: "ExecutionStatus", Operator: "IN", Values: []VisibilityValue{str("Running"), str("Failed")}}},
+			nil,
+		},
+		{
+			"NOT IN",
+			"ExecutionStatus not in ('Completed')",
+			[]VisibilityCondition{{Attribute: "ExecutionStatus", Operator: "NOT IN", Values: []VisibilityValue{str("Completed")}}},
+			nil,
+		},
+		{
+			"BETWEEN",
+			"StartTime BETWEEN '2024-01-01T00:00:00Z' AND '2024-02-01T00:00:00Z' AND WorkflowId = 'a'",
+			[]VisibilityCondition{
+				{Attribute: "StartTime", Operator: "BETWEEN", Values: []VisibilityValue{str("2024-01-01T00:00:00Z"), str("2024-02-01T00:00:00Z")}},
+				{Attribute: "WorkflowId", Operator: "=", Values: []VisibilityValue{str("a")}},
+			},
+			nil,
+		},
+		{
+			"NOT BETWEEN",
+			"HistoryLength NOT BETWEEN 10 AND 20",
+			[]VisibilityCondition{{Attribute: "HistoryLength", Operator: "NOT BETWEEN", Values: []VisibilityValue{{Kind: VisibilityNumber, Value: "10"}, {Kind: VisibilityNumber, Value: "20"}}}},
+			nil,
+		},
+		{
+			"STARTS_WITH",
+			"WorkflowId STARTS_WITH 'order-' OR WorkflowId NOT STARTS_WITH 'tmp'",
+			[]VisibilityCondition{
+				{Attribute: "WorkflowId", Operator: "STARTS_WITH", Values: []VisibilityValue{str("order-")}},
+				{Attribute: "WorkflowId", Operator: "NOT STARTS_WITH", Values: []VisibilityValue{str("tmp")}},
+			},
+			nil,
+		},
+		{
+			"IS NULL and IS NOT NULL",
+			"CloseTime IS NULL AND (ParentWorkflowId is not null)",
+			[]VisibilityCondition{
+				{Attribute: "CloseTime", Operator: "IS NULL"},
+				{Attribute: "ParentWorkflowId", Operator: "IS NOT NULL"},
+			},
+			nil,
+		},
+		{
+			"ORDER BY",
+			"ExecutionStatus = 'Running' ORDER BY StartTime DESC, `WorkflowId` asc, CloseTime",
+			[]VisibilityCondition{{Attribute: "ExecutionStatus", Operator: "=", Values: []VisibilityValue{str("Running")}}},
+			[]VisibilityOrder{{Attribute: "StartTime", Descending: true}, {Attribute: "WorkflowId"}, {Attribute: "CloseTime"}},
+		},
+		{
+			"ORDER BY alone",
+			"order by StartTime",
+			nil,
+			[]VisibilityOrder{{Attribute: "StartTime"}},
+		},
+		{
+			"doubled quote escapes",
+			`CustomerName = 'O''Brien' OR Note = "say ""hi"""`,
+			[]VisibilityCondition{
+				{Attribute: "CustomerName", Operator: "=", Values: []VisibilityValue{str("O'Brien")}},
+				{Attribute: "Note", Operator: "=", Values: []VisibilityValue{str(`say "hi"`)}},
+			},
+			nil,
+		},
+		{
+			"backslash escapes",
+			`CustomerName = 'O\'Brien'`,
+			[]VisibilityCondition{{Attribute: "CustomerName", Operator: "=", Values: []VisibilityValue{str("O'Brien")}}},
+			nil,
+		},
+		{
+			"time placeholders",
+			"StartTime > $TODAY AND CloseTime < $HOURS_AGO_6",
+			[]VisibilityCondition{
+				{Attribute: "StartTime", Operator: ">", Values: []VisibilityValue{{Kind: VisibilityPlaceholder, Value: "$TODAY"}}},
+				{Attribute: "CloseTime", Operator: "<", Values: []VisibilityValue{{Kind: VisibilityPlaceholder, Value: "$HOURS_AGO_6"}}},
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseVisibilityQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseVisibilityQuery(%q): %v", tt.query, err)
+			}
+			conditions := slices.Clone(q.Conditions)
+			for i := range conditions {
+				conditions[i].Start, conditions[i].End = 0, 0
+				conditions[i].Values = slices.Clone(conditions[i].Values)
+				for j := range conditions[i].Values {
+					conditions[i].Values[j].Start, conditions[i].Values[j].End = 0, 0
+				}
+			}
+			orderBy := slices.Clone(q.OrderBy)
+			for i := range orderBy {
+				orderBy[i].Start, orderBy[i].End = 0, 0
+			}
+			if !reflect.DeepEqual(conditions, tt.conditions) {
+				t.Errorf("conditions = %+v, want %+v", conditions, tt.conditions)
+			}
+			if !reflect.DeepEqual(orderBy, tt.orderBy) {
+				t.Errorf("order by = %+v, want %+v", orderBy, tt.orderBy)
+			}
+		})
+	}
+}
+
+func TestParseVisibilityQueryPositions(t *testing.T) {
+	query := "WorkflowId = 'a' AND ExecutionStatus IN ('Running') ORDER BY StartTime DESC"
+	q, err := ParseVisibilityQuery(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	span := func(start, end int) string { return query[start:end] }
+
+	if got := span(q.Conditions[0].Start, q.Conditions[0].End); got != "WorkflowId = 'a'" {
+		t.Errorf("first condition spans %q", got)
+	}
+	if got := span(q.Conditions[1].Start, q.Conditions[1].End); got != "ExecutionStatus IN ('Running')" {
+		t.Errorf("second condition spans %q", got)
+	}
+	if v := q.Conditions[0].Values[0]; span(v.Start, v.End) != "'a'" {
+		t.Errorf("value spans %q", span(v.Start, v.End))
+	}
+	if o := q.OrderBy[0]; span(o.Start, o.End) != "StartTime DESC" {
+		t.Errorf("order spans %q", span(o.Start, o.End))
+	}
+}
+
+func TestParseVisibilityQueryErrors(t *testing.T) {
+	tests := []struct {
+		query   string
+		marked  string // Text the view underlines; empty at the end of the query
+		message string
+	}{
+		{"WorkflowId = 'abc", "'abc", "unterminated string"},
+		{"`WorkflowId = 'a'", "`WorkflowId = 'a'", "unterminated `quoted` name"},
+		{"WorkflowId ! 'a'", "!", "expected != after !"},
+		{"WorkflowId = 'a' & x", "&", `unexpected character '&'`},
+		{"Attempts = 1.2.3", "1.2.3", "invalid number 1.2.3"},
+		{"WorkflowId = abc", "abc", "expected a value (strings need quotes), found abc"},
+		{"WorkflowId = ", "", "expected a value but the query ended"},
+		{"WorkflowId 'a'", "'a'", "expected an operator after WorkflowId, found 'a'"},
+		{"= 'a'", "=", "expected a search attribute, found ="},
+		{"WorkflowId = 'a' WorkflowType = 'b'", "WorkflowType", "expected AND, OR or ORDER BY, found WorkflowType"},
+		{"(WorkflowId = 'a'", "(", "unclosed parenthesis"},
+		{"WorkflowId = 'a')", ")", "unbalanced parenthesis, found )"},
+		{"WorkflowId IN ('a' 'b')", "'b'", "expected , or ) in IN list, found 'b'"},
+		{"WorkflowId IN 'a'", "'a'", "expected ( after IN, found 'a'"},
+		{"StartTime BETWEEN 1 OR 2", "OR", "expected AND, found OR"},
+		{"WorkflowId NOT = 'a'", "=", "expected IN, BETWEEN or STARTS_WITH after NOT, found ="},
+		{"CloseTime IS NOT 'x'", "'x'", "expected NULL, found 'x'"},
+		{"ORDER StartTime", "StartTime", "expected BY, found StartTime"},
+		{"ORDER BY 'StartTime'", "'StartTime'", "expected a search attribute to order by, found 'StartTime'"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			_, err := ParseVisibilityQuery(tt.query)
+			var qe *VisibilityQueryError
+			if !errors.As(err, &qe) {
+				t.Fatalf("ParseVisibilityQuery(%q) error = %v, want a *VisibilityQueryError", tt.query, err)
+			}
+			if qe.Message != tt.message {
+				t.Errorf("message = %q, want %q", qe.Message, tt.message)
+			}
+			start, end := min(qe.Start, len(tt.query)), min(qe.End, len(tt.query))
+			if marked := tt.query[start:end]; marked != tt.marked {
+				t.Errorf("error marks %q (%d-%d), want %q", marked, qe.Start, qe.End, tt.marked)
+			}
+		})
+	}
+}
+
+func TestVisibilityQueryValidate(t *testing.T) {
+	attrs := []SearchAttributeInfo{
+		{Name: "ExecutionStatus", Type: SearchAttributeKeyword, System: true},
+		{Name: "StartTime", Type: SearchAttributeDatetime, System: true},
+		{Name: "WorkflowId", Type: SearchAttributeKeyword, System: true},
+		{Name: "CustomerName", Type: SearchAttributeText},
+		{Name: "Attempts", Type: SearchAttributeInt},
+		{Name: "Score", Type: SearchAttributeDouble},
+		{Name: "IsPriority", Type: SearchAttributeBool},
+	}
+
+	tests := []struct {
+		query  string
+		attrs  []SearchAttributeInfo
+		marked string // Empty when the query is valid
+	}{
+		{"WorkflowId STARTS_WITH 'order-' AND Attempts > 3 ORDER BY StartTime DESC", attrs, ""},
+		{"StartTime > $DAYS_AGO_7 AND StartTime < '2024-01-02T15:04:05Z'", attrs, ""},
+		{"StartTime > 1704153600000000000", attrs, ""},
+		{"Score BETWEEN 0.5 AND 1 AND IsPriority = false", attrs, ""},
+		{"ExecutionStatus IN ('Running', 'ContinuedAsNew')", attrs, ""},
+		{"Unknown = 'a'", attrs, "Unknown"},
+		{"WorkflowId = 'a' ORDER BY Unknown", attrs, "Unknown"},
+		{"Unknown = 'a'", nil, ""}, // Nothing to check against until attributes load
+		{"ExecutionStatus = 'Sleeping'", nil, "'Sleeping'"},
+		{"ExecutionStatus = 1", attrs, "1"},
+		{"CustomerName STARTS_WITH 'O'", attrs, "CustomerName STARTS_WITH 'O'"},
+		{"IsPriority > true", attrs, "IsPriority > true"},
+		{"Attempts = 1.5", attrs, "1.5"},
+		{"Score = 'high'", attrs, "'high'"},
+		{"IsPriority = 'yes'", attrs, "'yes'"},
+		{"WorkflowId = 42", attrs, "42"},
+		{"StartTime > 'yesterday'", attrs, "'yesterday'"},
+		{"StartTime > $LAST_WEEK", attrs, "$LAST_WEEK"},
+		{"Attempts > $TODAY", attrs, "$TODAY"},
+		{"StartTime > $HOURS_AGO_", attrs, "$HOURS_AGO_"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			q, err := ParseVisibilityQuery(tt.query)
+			if err != nil {
+				t.Fatalf("ParseVisibilityQuery: %v", err)
+			}
+			err = q.Validate(tt.attrs)
+			if tt.marked == "" {
+				if err != nil {
+					t.Errorf("Validate: %v", err)
+				}
+				return
+			}
+			var qe *VisibilityQueryError
+			if !errors.As(err, &qe) {
+				t.Fatalf("Validate error = %v, want a *VisibilityQueryError", err)
+			}
+			if marked := tt.query[qe.Start:qe.End]; marked != tt.marked {
+				t.Errorf("error marks %q, want %q (%s)", marked, tt.marked, qe.Message)
+			}
+		})
+	}
+}
+
+func TestCompleteVisibilityQuery(t *testing.T) {
+	attrs := []SearchAttributeInfo{
+		{Name: "ExecutionStatus", Type: SearchAttributeKeyword, System: true},
+		{Name: "StartTime", Type: SearchAttributeDatetime, System: true},
+		{Name: "WorkflowType", Type: SearchAttributeKeyword, System: true},
+		{Name: "IsPriority", Type: SearchAttributeBool},
+	}
+	known := map[string][]string{"WorkflowType": {"OrderWorkflow", "Owner's"}}
+
+	tests := []struct {
+		name      string
+		query     string
+		wantStart int
+		want      []string // Completion texts
+	}{
+		{"attributes", "", 0, []string{"ExecutionStatus ", "StartTime ", "WorkflowType ", "IsPriority "}},
+		{"partial attribute", "Sta", 0, []string{"StartTime "}},
+		{"attribute after AND", "IsPriority = true AND exec", 22, []string{"ExecutionStatus "}},
+		{"bool operators", "IsPriority ", 11, []string{"= ", "!= ", "IN ", "NOT IN ", "IS NULL ", "IS NOT NULL "}},
+		{"after NOT", "WorkflowType NOT ", 17, []string{"IN ", "BETWEEN ", "STARTS_WITH "}},
+		{"after IS", "StartTime IS ", 13, []string{"NULL ", "NOT NULL "}},
+		{"statuses", "ExecutionStatus = 'Ru", 18, []string{"'Running' "}},
+		{"statuses in a list", "ExecutionStatus IN ('Running', 'F", 31, []string{"'Failed' "}},
+		{"bools", "IsPriority = ", 13, []string{"true ", "false "}},
+		{"known values are quoted", "WorkflowType = 'Ow", 15, []string{"'Owner''s' "}},
+		{"placeholders", "StartTime > $HOU", 12, []string{"$HOUR_AGO ", "$HOURS_AGO_6 "}},
+		{"BETWEEN needs AND", "StartTime BETWEEN $TODAY a", 25, []string{"AND "}},
+		{"connectors", "IsPriority = true o", 18, []string{"OR ", "ORDER BY "}},
+		{"complete query waits", "IsPriority = true ", 18, nil},
+		{"finished string waits for a space", "WorkflowType = 'OrderWorkflow'", 30, nil},
+		{"ORDER BY attributes", "IsPriority = true ORDER BY Start", 27, []string{"StartTime "}},
+		{"order direction", "ORDER BY StartTime d", 19, []string{"DESC "}},
+		{"nothing after a typed-out keyword", "ORDER BY StartTime DESC", 19, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, completions := CompleteVisibilityQuery(tt.query, len(tt.query), attrs, known)
+			var got []string
+			for _, c := range completions {
+				got = append(got, c.Text)
+			}
+			if start != tt.wantStart || !slices.Equal(got, tt.want) {
+				t.Errorf("CompleteVisibilityQuery(%q) = %d, %q; want %d, %q", tt.query, start, got, tt.wantStart, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoteVisibilityValue(t *testing.T) {
+	for _, s := range []string{"plain", "O'Brien", "''", `back\slash`, `it\'s`} {
+		q, err := ParseVisibilityQuery("WorkflowId = " + QuoteVisibilityValue(s))
+		if err != nil {
+			t.Fatalf("quoting %q: %v", s, err)
+		}
+		if got := q.Conditions[0].Values[0].Value; got != s {
+			t.Errorf("quoted %q parsed back as %q", s, got)
+		}
+	}
+}
+
+func TestWithoutOrderBy(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"", ""},
+		{"WorkflowType = 'OrderWorkflow'", "WorkflowType = 'OrderWorkflow'"},
+		{"WorkflowType = 'OrderWorkflow' ORDER BY StartTime DESC", "WorkflowType = 'OrderWorkflow'"},
+		{"ExecutionStatus = 'Running' order by StartTime, WorkflowId", "ExecutionStatus = 'Running'"},
+		{"ORDER BY StartTime", ""},
+		{"WorkflowId = 'ORDER BY StartTime'", "WorkflowId = 'ORDER BY StartTime'"},
+		{"WorkflowId = 'unterminated ORDER BY StartTime", "WorkflowId = 'unterminated ORDER BY StartTime"},
+	}
+	for _, tt := range tests {
+		if got := withoutOrderBy(tt.query); got != tt.want {
+			t.Errorf("withoutOrderBy(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// showActivityPicker lists the workflow's pending activities so one can be paused,
+// unpaused, reset or given new options.
+func (wd *WorkflowDetail) showActivityPicker() {
+	if wd.workflow == nil || len(wd.workflow.PendingActivities) == 0 {
+		wd.app.ShowToastInfo("No pending activities")
+		return
+	}
+	activities := wd.workflow.PendingActivities
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Pending Activities", theme.IconActivity),
+		Width:     110,
+		Height:    18,
+		MinHeight: 10,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("ID", "TYPE", "STATE", "ATTEMPT", "LAST FAILURE")
+	table.SetBackgroundColor(theme.Bg())
+	for _, pa := range activities {
+		table.AddRow(
+			pa.ActivityID,
+			pa.ActivityType,
+			pa.State,
+			strconv.Itoa(int(pa.Attempt)),
+			truncateStr(pa.LastFailure, 50),
+		)
+	}
+	table.SelectRow(0)
+
+	selected := func() (temporal.PendingActivity, bool) {
+		row := table.SelectedRow()
+		if row < 0 || row >= len(activities) {
+			return temporal.PendingActivity{}, false
+		}
+		return activities[row], true
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEscape:
+			wd.closeModal("activity-picker")
+			return nil
+		case tcell.KeyRune:
+			pa, ok := selected()
+			switch event.Rune() {
+			case 'q':
+				wd.closeModal("activity-picker")
+				return nil
+			case 'p':
+				if ok {
+					wd.closeModal("activity-picker")
+					wd.showPauseActivityConfirm(pa)
+				}
+				return nil
+			case 'u':
+				if ok {
+					wd.closeModal("activity-picker")
+					wd.showUnpauseActivityConfirm(pa)
+				}
+				return nil
+			case 'r':
+				if ok {
+					wd.closeModal("activity-picker")
+					wd.showResetActivityConfirm(pa)
+				}
+				return nil
+			case 'o':
+				if ok {
+					wd.closeModal("activity-picker")
+					wd.showActivityOptionsForm(pa)
+				}
+				return nil
+			}
+		}
+		return event
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "p", Description: "Pause"},
+		{Key: "u", Description: "Unpause"},
+		{Key: "r", Description: "Reset"},
+		{Key: "o", Description: "Options"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("activity-picker")
+	})
+
+	wd.app.JigApp().Pages().AddPage("activity-picker", modal, true, true)
+	wd.app.JigApp().SetFocus(table)
+}
+
+// showActivityConfirm shows an activity action's confirmation modal: a warning
+// above a form, submitted with Enter. submit returns an error to keep the modal
+// open when the form values are invalid.
+func (wd *WorkflowDetail) showActivityConfirm(name, title, warning string, height int, form *components.Form, action string, submit func(values map[string]any) error) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    title,
+		Width:    70,
+		Height:   height,
+		Backdrop: true,
+	})
+
+	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+	contentFlex.SetBackgroundColor(theme.Bg())
+
+	warningText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	warningText.SetBackgroundColor(theme.Bg())
+	warningText.SetText(warning)
+
+	onSubmit := func(values map[string]any) {
+		if err := submit(values); err != nil {
+			wd.app.ShowToastWarning(err.Error())
+			return
+		}
+		wd.closeModal(name)
+	}
+	form.SetOnSubmit(onSubmit)
+	form.SetOnCancel(func() {
+		wd.closeModal(name)
+	})
+
+	contentFlex.AddItem(warningText, strings.Count(warning, "\n")+2, 0, false)
+	contentFlex.AddItem(form, 0, 1, true)
+
+	modal.SetContent(contentFlex)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: action},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		onSubmit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal(name)
+	})
+
+	wd.app.JigApp().Pages().AddPage(name, modal, true, true)
+	wd.app.JigApp().SetFocus(form)
+}
+
+func (wd *WorkflowDetail) showPauseActivityConfirm(pa temporal.PendingActivity) {
+	form := components.NewForm()
+	form.AddTextField("reason", "Reason", "Paused via tempo")
+
+	warning := fmt.Sprintf("[%s]Pause activity %s (%s)?[-]\n[%s]It won't be retried until unpaused. A running attempt is not interrupted.[-]",
+		theme.TagWarning(), pa.ActivityID, pa.ActivityType, theme.TagFgDim())
+
+	wd.showActivityConfirm("pause-activity-confirm", fmt.Sprintf("%s Pause Activity", theme.IconPause), warning, 12, form, "Pause",
+		func(values map[string]any) error {
+			reason := values["reason"].(string)
+			wd.executeActivityAction("pause", func(ctx context.Context, provider temporal.Provider) error {
+				return provider.PauseActivity(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID, pa.ActivityID, reason)
+			})
+			return nil
+		})
+}
+
+func (wd *WorkflowDetail) showUnpauseActivityConfirm(pa temporal.PendingActivity) {
+	form := components.NewForm()
+	form.AddCheckbox("resetAttempts", "Reset attempts")
+
+	warning := fmt.Sprintf("[%s]Unpause activity %s (%s)?[-]\n[%s]It is scheduled again on its current attempt (%d) unless attempts are reset.[-]",
+		theme.TagWarning(), pa.ActivityID, pa.ActivityType, theme.TagFgDim(), pa.Attempt)
+
+	wd.showActivityConfirm("unpause-activity-confirm", fmt.Sprintf("%s Unpause Activity", theme.IconPlay), warning, 12, form, "Unpause",
+		func(values map[string]any) error {
+			resetAttempts := values["resetAttempts"].(bool)
+			wd.executeActivityAction("unpause", func(ctx context.Context, provider temporal.Provider) error {
+				return provider.UnpauseActivity(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID, pa.ActivityID, resetAttempts)
+			})
+			return nil
+		})
+}
+
+func (wd *WorkflowDetail) showResetActivityConfirm(pa temporal.PendingActivity) {
+	form := components.NewForm()
+	form.AddCheckbox("resetHeartbeat", "Reset heartbeat details")
+	form.AddCheckbox("keepPaused", "Keep paused")
+
+	warning := fmt.Sprintf("[%s]Reset activity %s (%s)?[-]\n[%s]Attempts start over from 1 and it is scheduled immediately.\nA running attempt's result will be ignored.[-]",
+		theme.TagWarning(), pa.ActivityID, pa.ActivityType, theme.TagFgDim())
+
+	wd.showActivityConfirm("reset-activity-confirm", fmt.Sprintf("%s Reset Activity", theme.IconReplay), warning, 14, form, "Reset",
+		func(values map[string]any) error {
+			resetHeartbeat := values["resetHeartbeat"].(bool)
+			keepPaused := values["keepPaused"].(bool)
+			wd.executeActivityAction("reset", func(ctx context.Context, provider temporal.Provider) error {
+				return provider.ResetActivity(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID, pa.ActivityID, resetHeartbeat, keepPaused)
+			})
+			return nil
+		})
+}
+
+// showActivityOptionsForm edits an activity's timeouts and retry policy. Fields are
+// prefilled with the options in effect; only changed fields are sent.
+func (wd *WorkflowDetail) showActivityOptionsForm(pa temporal.PendingActivity) {
+	var current temporal.ActivityOptions
+	if pa.Options != nil {
+		current = *pa.Options
+	}
+	formatDur := func(d time.Duration) string {
+		if d == 0 {
+			return ""
+		}
+		return d.String()
+	}
+
+	form := components.NewForm()
+	form.AddTextField("startToClose", "Start-to-Close", "e.g. 30s")
+	form.AddTextField("scheduleToClose", "Schedule-to-Close", "unlimited")
+	form.AddTextField("scheduleToStart", "Schedule-to-Start", "unlimited")
+	form.AddTextField("heartbeat", "Heartbeat Timeout", "none")
+	form.AddTextField("initialInterval", "Initial Interval", "e.g. 1s")
+	form.AddTextField("backoff", "Backoff Coefficient", "e.g. 2.0")
+	form.AddTextField("maximumInterval", "Maximum Interval", "e.g. 100s")
+	form.AddTextField("maximumAttempts", "Maximum Attempts", "unlimited")
+
+	initial := map[string]any{
+		"startToClose":    formatDur(current.StartToCloseTimeout),
+		"scheduleToClose": formatDur(current.ScheduleToCloseTimeout),
+		"scheduleToStart": formatDur(current.ScheduleToStartTimeout),
+		"heartbeat":       formatDur(current.HeartbeatTimeout),
+		"initialInterval": formatDur(current.InitialInterval),
+		"maximumInterval": formatDur(current.MaximumInterval),
+		"backoff":         "",
+		"maximumAttempts": "",
+	}
+	if current.BackoffCoefficient > 0 {
+		initial["backoff"] = strconv.FormatFloat(current.BackoffCoefficient, 'f', -1, 64)
+	}
+	if current.MaximumAttempts > 0 {
+		initial["maximumAttempts"] = strconv.Itoa(int(current.MaximumAttempts))
+	}
+	_ = form.SetValues(initial)
+
+	warning := fmt.Sprintf("[%s]Update options of activity %s (%s)[-]\n[%s]Only changed fields are sent. New values apply from the next attempt.[-]",
+		theme.TagWarning(), pa.ActivityID, pa.ActivityType, theme.TagFgDim())
+
+	wd.showActivityConfirm("activity-options-form", fmt.Sprintf("%s Activity Options", theme.IconSettings), warning, 24, form, "Update",
+		func(values map[string]any) error {
+			opts, err := parseActivityOptions(values, initial)
+			if err != nil {
+				return err
+			}
+			if opts == (temporal.ActivityOptions{}) {
+				return fmt.Errorf("no options changed")
+			}
+			wd.executeActivityAction("update", func(ctx context.Context, provider temporal.Provider) error {
+				_, err := provider.UpdateActivityOptions(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID, pa.ActivityID, opts)
+				return err
+			})
+			return nil
+		})
+}
+
+// parseActivityOptions reads the options form, keeping only the fields that differ
+// from their initial values.
+func parseActivityOptions(values, initial map[string]any) (temporal.ActivityOptions, error) {
+	var opts temporal.ActivityOptions
+	changed := func(name string) (string, bool) {
+		v := strings.TrimSpace(values[name].(string))
+		return v, v != "" && v != initial[name]
+	}
+
+	durations := []struct {
+		name, label string
+		dst         *time.Duration
+	}{
+		{"startToClose", "start-to-close timeout", &opts.StartToCloseTimeout},
+		{"scheduleToClose", "schedule-to-close timeout", &opts.ScheduleToCloseTimeout},
+		{"scheduleToStart", "schedule-to-start timeout", &opts.ScheduleToStartTimeout},
+		{"heartbeat", "heartbeat timeout", &opts.HeartbeatTimeout},
+		{"initialInterval", "initial interval", &opts.InitialInterval},
+		{"maximumInterval", "maximum interval", &opts.MaximumInterval},
+	}
+	for _, d := range durations {
+		v, ok := changed(d.name)
+		if !ok {
+			continue
+		}
+		parsed, err := time.ParseDuration(v)
+		if err != nil || parsed <= 0 {
+			return opts, fmt.Errorf("invalid %s: %s", d.label, v)
+		}
+		*d.dst = parsed
+	}
+
+	if v, ok := changed("backoff"); ok {
+		backoff, err := strconv.ParseFloat(v, 64)
+		if err != nil || backoff < 1 {
+			return opts, fmt.Errorf("invalid backoff coefficient: %s", v)
+		}
+		opts.BackoffCoefficient = backoff
+	}
+	if v, ok := changed("maximumAttempts"); ok {
+		attempts, err := strconv.Atoi(v)
+		if err != nil || attempts <= 0 {
+			return opts, fmt.Errorf("invalid maximum attempts: %s", v)
+		}
+		opts.MaximumAttempts = int32(attempts)
+	}
+	return opts, nil
+}
+
+// executeActivityAction runs an activity operation and refreshes the pending panel.
+func (wd *WorkflowDetail) executeActivityAction(verb string, action func(ctx context.Context, provider temporal.Provider) error) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := action(ctx, provider)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.app.ShowToastError(fmt.Sprintf("Failed to %s activity: %s", verb, err.Error()))
+				return
+			}
+			wd.reloadWorkflow()
+		})
+	}()
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/atterpac/jig/theme"
 	"github.com/atterpac/jig/theme/themes"
 	"github.com/galaxy-io/tempo/internal/config"
+	"github.com/galaxy-io/tempo/internal/mock"
 	"github.com/galaxy-io/tempo/internal/temporal"
 	"github.com/galaxy-io/tempo/internal/update"
 	"github.com/gdamore/tcell/v2"
@@ -35,6 +36,9 @@ type App struct {
 	namespaceList *NamespaceList
 	currentNS     string
 
+	// Bumped whenever a search attribute is added or removed, so cached lists reload
+	searchAttributesVersion int
+
 	// Connection monitor
 	stopMonitor  chan struct{}
 	reconnecting bool
@@ -43,18 +47,17 @@ type App struct {
 	config        *config.Config
 	activeProfile string
 
+	// Opened history file; the server provider is set aside until a profile is selected
+	historyFile  string
+	liveProvider temporal.Provider
+
 	// Dev mode
 	devMode bool
 }
 
-// NewApp creates a new application controller with no provider (uses mock data).
+// NewApp creates a new application controller backed by the in-memory mock provider.
 func NewApp() *App {
-	a := &App{
-		currentNS: "default",
-	}
-	a.buildApp()
-	a.setup()
-	return a
+	return NewAppWithProvider(mock.NewProvider(nil), "default", nil, "mock")
 }
 
 // NewAppWithProvider creates a new application controller with a Temporal provider.
@@ -124,6 +127,8 @@ func (a *App) setup() {
 		if strings.HasPrefix(text, "profile") {
 			args := strings.TrimPrefix(text, "profile")
 			a.handleProfileCommand(strings.TrimSpace(args))
+		} else if strings.HasPrefix(text, "open ") {
+			a.handleOpenCommand(strings.TrimSpace(strings.TrimPrefix(text, "open")))
 		}
 		// Restore focus to current view
 		if current := a.app.Pages().Current(); current != nil {
@@ -249,13 +254,16 @@ func (a *App) updateCrumbs() {
 		case "workflows":
 			path = []string{"Namespaces", a.currentNS, "Workflows"}
 		case "workflow-detail":
-			path = []string{"Namespaces", a.currentNS, "Workflows", "Detail"}
+			path = append([]string{"Namespaces", a.currentNS, "Workflows"}, a.workflowCrumbs()...)
 		case "events":
-			path = []string{"Namespaces", a.currentNS, "Workflows", "Detail", "Events"}
+			path = append([]string{"Namespaces", a.currentNS, "Workflows"}, a.workflowCrumbs()...)
+			path = append(path, "Events")
 		case "task-queues":
 			path = []string{"Namespaces", a.currentNS, "Task Queues"}
 		case "schedules":
 			path = []string{"Namespaces", a.currentNS, "Schedules"}
+		case "batch-operations":
+			path = []string{"Namespaces", a.currentNS, "Batch Operations"}
 		case "workflow-diff":
 			path = []string{"Namespaces", a.currentNS, "Workflows", "Diff"}
 		}
@@ -263,6 +271,21 @@ func (a *App) updateCrumbs() {
 	a.app.Crumbs().SetPath(path)
 }
 
+// workflowCrumbs returns one crumb per workflow detail on the page stack, so
+// drilling from a parent into its children shows the whole path.
+func (a *App) workflowCrumbs() []string {
+	var crumbs []string
+	for _, c := range a.app.Pages().GetStack() {
+		if wd, ok := c.(*WorkflowDetail); ok {
+			crumbs = append(crumbs, truncateStr(wd.workflowID, 24))
+		}
+	}
+	if len(crumbs) == 0 {
+		crumbs = []string{"Detail"}
+	}
+	return crumbs
+}
+
 // Status bar helpers
 // Section layout: [0] profile, [1] namespace, [2] connection status
 
@@ -313,9 +336,27 @@ func (a *App) setNamespace(ns string) {
 
 // WorkflowStats holds workflow count statistics.
 type WorkflowStats struct {
-	Running   int
-	Completed int
-	Failed    int
+	Running   int64
+	Completed int64
+	Failed    int64
+	TimedOut  int64
+	Other     int64 // Canceled, terminated and continued-as-new
+}
+
+// add counts n workflows with the given status.
+func (s *WorkflowStats) add(status string, n int64) {
+	switch status {
+	case temporal.StatusRunning:
+		s.Running += n
+	case temporal.StatusCompleted:
+		s.Completed += n
+	case temporal.StatusFailed:
+		s.Failed += n
+	case temporal.StatusTimedOut:
+		s.TimedOut += n
+	default:
+		s.Other += n
+	}
 }
 
 // SetWorkflowStats updates the workflow statistics in the status bar (right-aligned).
@@ -328,15 +369,23 @@ func (a *App) SetWorkflowStats(stats WorkflowStats) {
 	runningColor := theme.TagInfo()
 	completedColor := theme.TagSuccess()
 	failedColor := theme.TagError()
+	timedOutColor := theme.TagWarning()
+	otherColor := theme.TagFgMuted()
 
 	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Running:[-] [%s]%d[-]", dimTag, runningColor, stats.Running),
+		Text: fmt.Sprintf("[%s]Running:[-] [%s]%s[-]", dimTag, runningColor, formatCount(stats.Running)),
+	})
+	a.statusBar.AddRightSection(layout.StatusSection{
+		Text: fmt.Sprintf("[%s]Completed:[-] [%s]%s[-]", dimTag, completedColor, formatCount(stats.Completed)),
 	})
 	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Completed:[-] [%s]%d[-]", dimTag, completedColor, stats.Completed),
+		Text: fmt.Sprintf("[%s]Failed:[-] [%s]%s[-]", dimTag, failedColor, formatCount(stats.Failed)),
 	})
 	a.statusBar.AddRightSection(layout.StatusSection{
-		Text: fmt.Sprintf("[%s]Failed:[-] [%s]%d[-]", dimTag, failedColor, stats.Failed),
+		Text: fmt.Sprintf("[%s]Timed Out:[-] [%s]%s[-]", dimTag, timedOutColor, formatCount(stats.TimedOut)),
+	})
+	a.statusBar.AddRightSection(layout.StatusSection{
+		Text: fmt.Sprintf("[%s]Other:[-] [%s]%s[-]", dimTag, otherColor, formatCount(stats.Other)),
 	})
 }
 
@@ -361,6 +410,11 @@ func (a *App) SetNamespace(ns string) {
 	a.setNamespace(ns)
 }
 
+// SearchAttributesChanged marks search attributes cached by views as stale.
+func (a *App) SearchAttributesChanged() {
+	a.searchAttributesVersion++
+}
+
 // CurrentNamespace returns the current namespace.
 func (a *App) CurrentNamespace() string {
 	return a.currentNS
@@ -397,6 +451,12 @@ func (a *App) NavigateToSchedules() {
 	a.app.Pages().Push(sl)
 }
 
+// NavigateToBatchOperations pushes the batch operations view.
+func (a *App) NavigateToBatchOperations() {
+	bl := NewBatchList(a, a.currentNS)
+	a.app.Pages().Push(bl)
+}
+
 // NavigateToNamespaceDetail pushes the namespace detail view.
 func (a *App) NavigateToNamespaceDetail(namespace string) {
 	nd := NewNamespaceDetail(a, namespace)
@@ -472,6 +532,13 @@ func (a *App) ShowToastError(message string) {
 	})
 }
 
+// ShowToastInfo displays an informational toast notification.
+func (a *App) ShowToastInfo(message string) {
+	a.app.QueueUpdateDraw(func() {
+		a.toasts.Info(message)
+	})
+}
+
 // ShowToastWarning displays a warning toast notification.
 func (a *App) ShowToastWarning(message string) {
 	a.app.QueueUpdateDraw(func() {
@@ -948,8 +1015,9 @@ func (a *App) deleteProfile(name string) {
 }
 
 // SwitchProfile switches to a different connection profile.
+// When a history file is open, this also returns to the server.
 func (a *App) SwitchProfile(name string) {
-	if a.config == nil || a.provider == nil {
+	if a.config == nil || (a.provider == nil && a.historyFile == "") {
 		return
 	}
 
@@ -966,6 +1034,15 @@ func (a *App) SwitchProfile(name string) {
 		TLSCAPath:     profileCfg.TLS.CA,
 		TLSServerName: profileCfg.TLS.ServerName,
 		TLSSkipVerify: profileCfg.TLS.SkipVerify,
+		TLSEnabled:    profileCfg.TLS.Enabled,
+		CodecEndpoint: profileCfg.CodecEndpoint,
+
+		ProtoDescriptorPaths: profileCfg.ProtoDescriptors,
+
+		APIKey:        profileCfg.APIKey,
+		APIKeyEnv:     profileCfg.APIKeyEnv,
+		APIKeyCommand: profileCfg.APIKeyCommand,
+		Headers:       profileCfg.Headers,
 	}
 
 	// Stop current views
@@ -977,9 +1054,23 @@ func (a *App) SwitchProfile(name string) {
 	a.setProfile(name + " (connecting...)")
 	a.setConnected(false)
 
+	provider := a.provider
+	if a.historyFile != "" {
+		provider = a.liveProvider
+	}
+
 	go func() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-		err := a.provider.ReconnectWithConfig(ctx, connConfig)
+		var err error
+		if provider != nil {
+			err = provider.ReconnectWithConfig(ctx, connConfig)
+		} else {
+			// Started with `tempo open`, so there is no server connection yet
+			var client *temporal.Client
+			if client, err = temporal.NewClient(ctx, connConfig); err == nil {
+				provider = client
+			}
+		}
 		cancel()
 
 		a.app.QueueUpdateDraw(func() {
@@ -988,6 +1079,9 @@ func (a *App) SwitchProfile(name string) {
 				a.setConnected(false)
 				return
 			}
+			if a.historyFile != "" {
+				a.closeHistoryFile(provider)
+			}
 
 			a.activeProfile = name
 			a.currentNS = connConfig.Namespace
@@ -1155,6 +1249,8 @@ func (a *App) restoreDefaultCommandCallbacks() {
 		if strings.HasPrefix(text, "profile") {
 			args := strings.TrimPrefix(text, "profile")
 			a.handleProfileCommand(strings.TrimSpace(args))
+		} else if strings.HasPrefix(text, "open ") {
+			a.handleOpenCommand(strings.TrimSpace(strings.TrimPrefix(text, "open")))
 		}
 		// Restore focus to current view
 		if current := a.app.Pages().Current(); current != nil {
@@ -0,0 +1,478 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// batchRefreshInterval controls how often running batch jobs are polled for progress.
+const batchRefreshInterval = 3 * time.Second
+
+// BatchList displays server-side batch operations with their progress.
+type BatchList struct {
+	*tview.Flex
+	app           *App
+	namespace     string
+	table         *components.Table
+	leftPanel     *components.Panel
+	rightPanel    *components.Panel
+	preview       *tview.TextView
+	jobs          []temporal.BatchOperation
+	finished      map[string]temporal.BatchOperation // Described jobs that can no longer change
+	loading       bool
+	showPreview   bool
+	refreshTicker *time.Ticker
+	stopRefresh   chan struct{}
+}
+
+// NewBatchList creates a new batch operations view.
+func NewBatchList(app *App, namespace string) *BatchList {
+	bl := &BatchList{
+		Flex:        tview.NewFlex().SetDirection(tview.FlexColumn),
+		app:         app,
+		namespace:   namespace,
+		table:       components.NewTable(),
+		preview:     tview.NewTextView(),
+		jobs:        []temporal.BatchOperation{},
+		finished:    make(map[string]temporal.BatchOperation),
+		showPreview: true,
+		stopRefresh: make(chan struct{}),
+	}
+	bl.setup()
+	return bl
+}
+
+func (bl *BatchList) setup() {
+	bl.table.SetHeaders("JOB ID", "TYPE", "STATE", "PROGRESS", "FAILED", "STARTED")
+	bl.table.SetBorder(false)
+	bl.table.SetBackgroundColor(theme.Bg())
+	bl.SetBackgroundColor(theme.Bg())
+
+	// Configure preview
+	bl.preview.SetDynamicColors(true)
+	bl.preview.SetBackgroundColor(theme.Bg())
+	bl.preview.SetTextColor(theme.Fg())
+	bl.preview.SetWordWrap(true)
+
+	bl.leftPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Batch Operations", theme.IconList))
+	bl.leftPanel.SetContent(bl.table)
+
+	bl.rightPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Preview", theme.IconInfo))
+	bl.rightPanel.SetContent(bl.preview)
+
+	// Selection change handler to update preview
+	bl.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row-1 < len(bl.jobs) {
+			bl.updatePreview(bl.jobs[row-1])
+		}
+	})
+
+	bl.buildLayout()
+}
+
+func (bl *BatchList) buildLayout() {
+	bl.Clear()
+	if bl.showPreview {
+		bl.AddItem(bl.leftPanel, 0, 3, true)
+		bl.AddItem(bl.rightPanel, 0, 2, false)
+	} else {
+		bl.AddItem(bl.leftPanel, 0, 1, true)
+	}
+}
+
+func (bl *BatchList) togglePreview() {
+	bl.showPreview = !bl.showPreview
+	bl.buildLayout()
+}
+
+// RefreshTheme updates all component colors after a theme change.
+func (bl *BatchList) RefreshTheme() {
+	bg := theme.Bg()
+
+	bl.SetBackgroundColor(bg)
+	bl.table.SetBackgroundColor(bg)
+	bl.preview.SetBackgroundColor(bg)
+	bl.preview.SetTextColor(theme.Fg())
+
+	bl.populateTable()
+}
+
+// batchProgress formats processed/total with a percentage.
+func batchProgress(job temporal.BatchOperation) string {
+	if job.TotalCount <= 0 {
+		return "-"
+	}
+	processed := job.CompletedCount + job.FailureCount
+	return fmt.Sprintf("%d/%d (%d%%)", processed, job.TotalCount, processed*100/job.TotalCount)
+}
+
+func (bl *BatchList) updatePreview(job temporal.BatchOperation) {
+	closed := "-"
+	if job.CloseTime != nil {
+		closed = formatRelativeTime(time.Now(), *job.CloseTime)
+	}
+
+	text := fmt.Sprintf(`[%s::b]Batch Operation[-:-:-]
+[%s]%s[-]
+
+[%s]Type[-]
+[%s]%s[-]
+
+[%s]State[-]
+[%s]%s[-]
+
+[%s]Progress[-]
+[%s]%s[-]
+
+[%s]Completed / Failed / Total[-]
+[%s]%d[-] / [%s]%d[-] / [%s]%d[-]
+
+[%s]Started[-]
+[%s]%s[-]
+
+[%s]Closed[-]
+[%s]%s[-]
+
+[%s]Reason[-]
+[%s]%s[-]
+
+[%s]Identity[-]
+[%s]%s[-]`,
+		theme.TagAccent(),
+		theme.TagFg(), job.JobID,
+		theme.TagFgDim(),
+		theme.TagFg(), valueOrDash(job.Type),
+		theme.TagFgDim(),
+		theme.StatusColorTag(job.State), job.State,
+		theme.TagFgDim(),
+		theme.TagFg(), batchProgress(job),
+		theme.TagFgDim(),
+		theme.TagSuccess(), job.CompletedCount,
+		theme.TagError(), job.FailureCount,
+		theme.TagFg(), job.TotalCount,
+		theme.TagFgDim(),
+		theme.TagFg(), formatRelativeTime(time.Now(), job.StartTime),
+		theme.TagFgDim(),
+		theme.TagFg(), closed,
+		theme.TagFgDim(),
+		theme.TagFgDim(), tview.Escape(valueOrDash(job.Reason)),
+		theme.TagFgDim(),
+		theme.TagFg(), tview.Escape(valueOrDash(job.Identity)),
+	)
+	bl.preview.SetText(text)
+}
+
+func valueOrDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (bl *BatchList) loadData() {
+	provider := bl.app.Provider()
+	if provider == nil || bl.loading {
+		return
+	}
+
+	// Only this load touches the cache until it finishes, since loading blocks the next one
+	finished := bl.finished
+	bl.loading = true
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		jobs, _, err := provider.ListBatchOperations(ctx, bl.namespace, temporal.ListOptions{PageSize: 50})
+		var described []temporal.BatchOperation
+		if err == nil {
+			// The list only carries state and timestamps; describe jobs for progress,
+			// but only once for jobs that have already finished.
+			var mu sync.Mutex
+			var wg sync.WaitGroup
+			for i := range jobs {
+				if cached, ok := finished[jobs[i].JobID]; ok && jobs[i].State != temporal.BatchStateRunning {
+					jobs[i] = cached
+					continue
+				}
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					if detail, err := provider.DescribeBatchOperation(ctx, bl.namespace, jobs[i].JobID); err == nil {
+						jobs[i] = *detail
+						mu.Lock()
+						described = append(described, *detail)
+						mu.Unlock()
+					}
+				}(i)
+			}
+			wg.Wait()
+		}
+
+		bl.app.JigApp().QueueUpdateDraw(func() {
+			bl.loading = false
+			if err != nil {
+				bl.showError(err)
+				return
+			}
+			for _, job := range described {
+				if job.State != temporal.BatchStateRunning {
+					bl.finished[job.JobID] = job
+				}
+			}
+			bl.jobs = jobs
+			bl.populateTable()
+		})
+	}()
+}
+
+func (bl *BatchList) populateTable() {
+	// Preserve current selection
+	currentRow := bl.table.SelectedRow()
+
+	bl.table.ClearRows()
+	bl.table.SetHeaders("JOB ID", "TYPE", "STATE", "PROGRESS", "FAILED", "STARTED")
+
+	if len(bl.jobs) == 0 {
+		bl.preview.SetText(fmt.Sprintf("[%s]No batch operations in %s[-]", theme.TagFgDim(), bl.namespace))
+		return
+	}
+
+	for _, job := range bl.jobs {
+		bl.table.AddRowWithColor(theme.StatusColor(job.State),
+			truncate(job.JobID, 30),
+			valueOrDash(job.Type),
+			job.State,
+			batchProgress(job),
+			fmt.Sprintf("%d", job.FailureCount),
+			formatRelativeTime(time.Now(), job.StartTime),
+		)
+	}
+
+	// Restore previous selection if valid, otherwise select first row
+	if currentRow >= 0 && currentRow < len(bl.jobs) {
+		bl.table.SelectRow(currentRow)
+		bl.updatePreview(bl.jobs[currentRow])
+	} else {
+		bl.table.SelectRow(0)
+		bl.updatePreview(bl.jobs[0])
+	}
+}
+
+func (bl *BatchList) showError(err error) {
+	bl.table.ClearRows()
+	bl.table.SetHeaders("JOB ID", "TYPE", "STATE", "PROGRESS", "FAILED", "STARTED")
+	bl.table.AddRowWithColor(theme.Error(),
+		theme.IconError+" Error loading batch operations",
+		err.Error(),
+		"",
+		"",
+		"",
+		"",
+	)
+}
+
+func (bl *BatchList) getSelectedJob() *temporal.BatchOperation {
+	row := bl.table.SelectedRow()
+	if row >= 0 && row < len(bl.jobs) {
+		return &bl.jobs[row]
+	}
+	return nil
+}
+
+// hasRunningJobs reports whether any listed job is still in progress.
+func (bl *BatchList) hasRunningJobs() bool {
+	for _, job := range bl.jobs {
+		if job.State == temporal.BatchStateRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// startAutoRefresh polls for progress while any job is running.
+func (bl *BatchList) startAutoRefresh() {
+	bl.refreshTicker = time.NewTicker(batchRefreshInterval)
+	ticker := bl.refreshTicker
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				bl.app.JigApp().QueueUpdateDraw(func() {
+					if bl.hasRunningJobs() {
+						bl.loadData()
+					}
+				})
+			case <-bl.stopRefresh:
+				return
+			}
+		}
+	}()
+}
+
+func (bl *BatchList) stopAutoRefresh() {
+	if bl.refreshTicker != nil {
+		bl.refreshTicker.Stop()
+		bl.refreshTicker = nil
+	}
+	select {
+	case bl.stopRefresh <- struct{}{}:
+	default:
+	}
+}
+
+func (bl *BatchList) showStopConfirm() {
+	job := bl.getSelectedJob()
+	if job == nil || job.State != temporal.BatchStateRunning {
+		return
+	}
+	jobID := job.JobID
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Stop Batch Operation", theme.IconStop),
+		Width:    65,
+		Height:   13,
+		Backdrop: true,
+	})
+
+	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+	contentFlex.SetBackgroundColor(theme.Bg())
+
+	infoText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	infoText.SetBackgroundColor(theme.Bg())
+	infoText.SetText(fmt.Sprintf(`[%s]Workflows already processed will not be reverted.[-]
+
+[%s]Job:[-] [%s]%s[-]
+[%s]Progress:[-] [%s]%s[-]`,
+		theme.TagError(),
+		theme.TagFgDim(), theme.TagFg(), jobID,
+		theme.TagFgDim(), theme.TagFg(), batchProgress(*job)))
+
+	form := components.NewForm()
+	form.AddTextField("reason", "Reason", "Stopped via tempo")
+	form.SetOnSubmit(func(values map[string]any) {
+		reason := values["reason"].(string)
+		bl.closeModal("stop-confirm")
+		bl.executeStop(jobID, reason)
+	})
+	form.SetOnCancel(func() {
+		bl.closeModal("stop-confirm")
+	})
+
+	contentFlex.AddItem(infoText, 5, 0, false)
+	contentFlex.AddItem(form, 0, 1, true)
+
+	modal.SetContent(contentFlex)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Stop"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		values := form.GetValues()
+		reason := values["reason"].(string)
+		bl.closeModal("stop-confirm")
+		bl.executeStop(jobID, reason)
+	})
+	modal.SetOnCancel(func() {
+		bl.closeModal("stop-confirm")
+	})
+
+	bl.app.JigApp().Pages().AddPage("stop-confirm", modal, true, true)
+	bl.app.JigApp().SetFocus(form)
+}
+
+func (bl *BatchList) executeStop(jobID, reason string) {
+	provider := bl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := provider.StopBatchOperation(ctx, bl.namespace, jobID, reason)
+
+		bl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				ShowErrorModal(bl.app.JigApp(), "Stop Failed", err.Error())
+				return
+			}
+			bl.loadData() // Refresh to show the stopped state
+		})
+	}()
+}
+
+func (bl *BatchList) closeModal(name string) {
+	bl.app.JigApp().Pages().RemovePage(name)
+	if current := bl.app.JigApp().Pages().Current(); current != nil {
+		bl.app.JigApp().SetFocus(current)
+	}
+}
+
+// Name returns the view name.
+func (bl *BatchList) Name() string {
+	return "batch-operations"
+}
+
+// Start is called when the view becomes active.
+func (bl *BatchList) Start() {
+	bl.table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'r':
+			bl.loadData()
+			return nil
+		case 'p':
+			bl.togglePreview()
+			return nil
+		case 'S':
+			bl.showStopConfirm()
+			return nil
+		}
+		return event
+	})
+	bl.loadData()
+	bl.startAutoRefresh()
+}
+
+// Stop is called when the view is deactivated.
+func (bl *BatchList) Stop() {
+	bl.table.SetInputCapture(nil)
+	bl.stopAutoRefresh()
+}
+
+// Hints returns keybinding hints for this view.
+func (bl *BatchList) Hints() []KeyHint {
+	hints := []KeyHint{
+		{Key: "r", Description: "Refresh"},
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "p", Description: "Preview"},
+		{Key: "S", Description: "Stop Job"},
+		{Key: "T", Description: "Theme"},
+		{Key: "esc", Description: "Back"},
+	}
+	return hints
+}
+
+// Focus sets focus to the table.
+func (bl *BatchList) Focus(delegate func(p tview.Primitive)) {
+	delegate(bl.table)
+}
+
+// Draw applies theme colors dynamically and draws the view.
+func (bl *BatchList) Draw(screen tcell.Screen) {
+	bg := theme.Bg()
+	bl.SetBackgroundColor(bg)
+	bl.preview.SetBackgroundColor(bg)
+	bl.preview.SetTextColor(theme.Fg())
+	bl.Flex.Draw(screen)
+}
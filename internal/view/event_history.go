@@ -1,7 +1,6 @@
 package view
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -37,8 +36,9 @@ type EventHistory struct {
 	table *components.Table
 
 	// Tree view components
-	treeView  *EventTreeView
-	treeNodes []*temporal.EventTreeNode
+	treeView    *EventTreeView
+	treeNodes   []*temporal.EventTreeNode
+	treeBuilder *temporal.EventTreeBuilder
 
 	// Timeline view components
 	timelineView *TimelineView
@@ -53,6 +53,12 @@ type EventHistory struct {
 	events         []temporal.HistoryEvent
 	enhancedEvents []temporal.EnhancedHistoryEvent
 	loading        bool
+
+	// Pages are rendered as they load; follow mode appends events as they arrive
+	loader        historyLoader
+	partial       bool // Loading was stopped before the whole history was read
+	follower      historyFollower
+	followPending bool // Follow once the loader finishes, so followed events can't skip its pages
 }
 
 // NewEventHistory creates a new event history view.
@@ -69,6 +75,8 @@ func NewEventHistory(app *App, workflowID, runID string) *EventHistory {
 		sidePanel:    tview.NewTextView(),
 		sidePanelOn:  true,
 	}
+	eh.loader.app = app
+	eh.follower.app = app
 	eh.setup()
 	return eh
 }
@@ -115,15 +123,23 @@ func (eh *EventHistory) setup() {
 	})
 
 	eh.treeView.SetOnSelect(func(node *temporal.EventTreeNode) {
-		// Toggle expand/collapse is handled by tree view itself
-		// Optionally toggle side panel on enter
+		// Toggle expand/collapse is handled by tree view itself;
+		// child workflow nodes drill into the child
+		if node != nil && node.Type == temporal.GroupChildWorkflow {
+			eh.openChildWorkflow(node)
+		}
 	})
 
 	// Timeline view selection handler (Enter key)
 	eh.timelineView.SetOnSelect(func(lane *TimelineLane) {
-		if lane != nil && lane.Node != nil {
-			eh.updateSidePanelFromTree(lane.Node)
+		if lane == nil || lane.Node == nil {
+			return
+		}
+		if lane.Node.Type == temporal.GroupChildWorkflow {
+			eh.openChildWorkflow(lane.Node)
+			return
 		}
+		eh.updateSidePanelFromTree(lane.Node)
 	})
 
 	// Timeline view selection change handler (navigation)
@@ -140,15 +156,13 @@ func (eh *EventHistory) buildLayout() {
 	eh.Clear()
 
 	// Update panel title and content based on view mode
+	eh.updateTitle()
 	switch eh.viewMode {
 	case ViewModeList:
-		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (List)", theme.IconEvent))
 		eh.leftPanel.SetContent(eh.table)
 	case ViewModeTree:
-		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (Tree)", theme.IconEvent))
 		eh.leftPanel.SetContent(eh.treeView)
 	case ViewModeTimeline:
-		eh.leftPanel.SetTitle(fmt.Sprintf("%s Events (Timeline)", theme.IconEvent))
 		eh.leftPanel.SetContent(eh.timelineView)
 	}
 
@@ -172,6 +186,30 @@ func (eh *EventHistory) buildLayout() {
 	}
 }
 
+// updateTitle sets the events panel title for the view mode and follow state.
+func (eh *EventHistory) updateTitle() {
+	var mode string
+	switch eh.viewMode {
+	case ViewModeList:
+		mode = "List"
+	case ViewModeTree:
+		mode = "Tree"
+	case ViewModeTimeline:
+		mode = "Timeline"
+	}
+	title := fmt.Sprintf("%s Events (%s)", theme.IconEvent, mode)
+	switch {
+	case eh.loader.Active():
+		title += fmt.Sprintf(" %s Loading %s", theme.IconPending, eh.loader.Progress())
+	case eh.partial:
+		title += fmt.Sprintf(" %s Partial: %s", theme.IconWarning, eh.loader.Progress())
+	}
+	if eh.following() {
+		title += fmt.Sprintf(" %s Following", theme.IconRunning)
+	}
+	eh.leftPanel.SetTitle(title)
+}
+
 func (eh *EventHistory) setViewMode(mode EventViewMode) {
 	if eh.viewMode == mode {
 		return
@@ -222,86 +260,156 @@ func (eh *EventHistory) RefreshTheme() {
 }
 
 func (eh *EventHistory) loadData() {
-	provider := eh.app.Provider()
-	if provider == nil {
-		eh.loadMockData()
+	if eh.app.Provider() == nil {
 		return
 	}
 
-	eh.setLoading(true)
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
+	// Start over; pages are added to every view as they arrive
+	eh.enhancedEvents = nil
+	eh.events = nil
+	eh.treeBuilder = temporal.NewEventTreeBuilder()
+	eh.treeNodes = nil
+	eh.partial = false
+	eh.refreshCurrentView()
 
-		// Load enhanced events for tree/timeline views
-		enhancedEvents, err := provider.GetEnhancedWorkflowHistory(ctx, eh.app.CurrentNamespace(), eh.workflowID, eh.runID)
+	// A follower would race the reload's pages, so it resumes once they are in
+	if eh.follower.Active() {
+		eh.follower.Stop()
+		eh.followPending = true
+	}
 
-		eh.app.JigApp().QueueUpdateDraw(func() {
-			eh.setLoading(false)
-			if err != nil {
-				eh.showError(err)
-				return
-			}
+	eh.setLoading(true)
+	eh.loader.Start(eh.workflowID, eh.runID, func(events []temporal.EnhancedHistoryEvent) {
+		eh.appendEvents(events)
+		eh.updateTitle()
+	}, func(err error) {
+		eh.setLoading(false)
+		if err != nil {
+			eh.followPending = false
+		} else if eh.followPending {
+			eh.startFollow()
+		}
+		eh.updateTitle()
+		eh.app.JigApp().Menu().SetHints(eh.Hints())
+		if err != nil {
+			eh.showError(err)
+		}
+	})
+	eh.updateTitle()
+	eh.app.JigApp().Menu().SetHints(eh.Hints())
+}
 
-			eh.enhancedEvents = enhancedEvents
+// stopLoading cancels a history load, keeping the events loaded so far.
+func (eh *EventHistory) stopLoading() {
+	if !eh.loader.Active() {
+		return
+	}
+	eh.loader.Stop()
+	eh.setLoading(false)
+	eh.partial = true
+	eh.followPending = false
+	eh.updateTitle()
+	eh.app.JigApp().Menu().SetHints(eh.Hints())
+}
 
-			// Convert to basic events for list view
-			eh.events = make([]temporal.HistoryEvent, len(enhancedEvents))
-			for i, ev := range enhancedEvents {
-				eh.events[i] = temporal.HistoryEvent{
-					ID:      ev.ID,
-					Type:    ev.Type,
-					Time:    ev.Time,
-					Details: ev.Details,
-				}
-			}
+// toggleFollow starts or stops following new events for a running workflow.
+func (eh *EventHistory) toggleFollow() {
+	if eh.following() {
+		eh.follower.Stop()
+		eh.followPending = false
+		eh.updateTitle()
+		eh.app.JigApp().Menu().SetHints(eh.Hints())
+		return
+	}
+	if eh.loader.Active() {
+		eh.followPending = true
+		eh.updateTitle()
+		eh.app.JigApp().Menu().SetHints(eh.Hints())
+		return
+	}
+	eh.startFollow()
+}
 
-			// Build tree nodes
-			eh.treeNodes = temporal.BuildEventTree(enhancedEvents)
+// following reports whether new events are followed or will be once loading finishes.
+func (eh *EventHistory) following() bool {
+	return eh.follower.Active() || eh.followPending
+}
 
-			// Populate current view
-			eh.refreshCurrentView()
-		})
-	}()
+// startFollow follows events after the last one loaded.
+func (eh *EventHistory) startFollow() {
+	eh.followPending = false
+	var lastEventID int64
+	if n := len(eh.enhancedEvents); n > 0 {
+		lastEventID = eh.enhancedEvents[n-1].ID
+	}
+	eh.follower.Start(eh.workflowID, eh.runID, lastEventID, eh.appendEvents, func(err error) {
+		eh.updateTitle()
+		eh.app.JigApp().Menu().SetHints(eh.Hints())
+		if err != nil {
+			eh.app.ShowToastError(fmt.Sprintf("Follow stopped: %s", err.Error()))
+			return
+		}
+		eh.app.ShowToastInfo("Workflow closed, stopped following")
+	})
+	eh.updateTitle()
+	eh.app.JigApp().Menu().SetHints(eh.Hints())
 }
 
-func (eh *EventHistory) loadMockData() {
-	now := time.Now()
-
-	// Create mock enhanced events
-	eh.enhancedEvents = []temporal.EnhancedHistoryEvent{
-		{ID: 1, Type: "WorkflowExecutionStarted", Time: now.Add(-5 * time.Minute), Details: "WorkflowType: MockWorkflow, TaskQueue: mock-tasks", TaskQueue: "mock-tasks"},
-		{ID: 2, Type: "WorkflowTaskScheduled", Time: now.Add(-5 * time.Minute), Details: "TaskQueue: mock-tasks", TaskQueue: "mock-tasks"},
-		{ID: 3, Type: "WorkflowTaskStarted", Time: now.Add(-5 * time.Minute), Details: "Identity: worker-1@host", ScheduledEventID: 2, Identity: "worker-1@host"},
-		{ID: 4, Type: "WorkflowTaskCompleted", Time: now.Add(-5 * time.Minute), Details: "ScheduledEventId: 2", ScheduledEventID: 2, StartedEventID: 3},
-		{ID: 5, Type: "ActivityTaskScheduled", Time: now.Add(-4 * time.Minute), Details: "ActivityType: ValidateOrder, TaskQueue: mock-tasks", ActivityType: "ValidateOrder", ActivityID: "1", TaskQueue: "mock-tasks"},
-		{ID: 6, Type: "ActivityTaskStarted", Time: now.Add(-4 * time.Minute), Details: "Identity: worker-1@host, Attempt: 1", ScheduledEventID: 5, Attempt: 1, Identity: "worker-1@host"},
-		{ID: 7, Type: "ActivityTaskCompleted", Time: now.Add(-3 * time.Minute), Details: "ScheduledEventId: 5, Result: {success: true}", ScheduledEventID: 5, StartedEventID: 6, Result: "{success: true}"},
-		{ID: 8, Type: "ActivityTaskScheduled", Time: now.Add(-3 * time.Minute), Details: "ActivityType: ProcessPayment, TaskQueue: mock-tasks", ActivityType: "ProcessPayment", ActivityID: "2", TaskQueue: "mock-tasks"},
-		{ID: 9, Type: "ActivityTaskStarted", Time: now.Add(-3 * time.Minute), Details: "Identity: worker-1@host, Attempt: 1", ScheduledEventID: 8, Attempt: 1, Identity: "worker-1@host"},
-		{ID: 10, Type: "ActivityTaskFailed", Time: now.Add(-2 * time.Minute), Details: "ScheduledEventId: 8, Failure: timeout", ScheduledEventID: 8, StartedEventID: 9, Failure: "timeout"},
-		{ID: 11, Type: "ActivityTaskStarted", Time: now.Add(-2 * time.Minute), Details: "Identity: worker-1@host, Attempt: 2", ScheduledEventID: 8, Attempt: 2, Identity: "worker-1@host"},
-		{ID: 12, Type: "ActivityTaskCompleted", Time: now.Add(-1 * time.Minute), Details: "ScheduledEventId: 8, Result: {paid: true}", ScheduledEventID: 8, StartedEventID: 11, Result: "{paid: true}"},
-		{ID: 13, Type: "TimerStarted", Time: now.Add(-1 * time.Minute), Details: "TimerId: wait-30s", TimerID: "wait-30s"},
-		{ID: 14, Type: "TimerFired", Time: now.Add(-30 * time.Second), Details: "TimerId: wait-30s, StartedEventId: 13", TimerID: "wait-30s", StartedEventID: 13},
-	}
-
-	// Convert to basic events
-	eh.events = make([]temporal.HistoryEvent, len(eh.enhancedEvents))
-	for i, ev := range eh.enhancedEvents {
-		eh.events[i] = temporal.HistoryEvent{
+// appendEvents adds newly arrived events to every view, rebuilding only the
+// tree nodes they belong to so selection and expand state are kept.
+func (eh *EventHistory) appendEvents(events []temporal.EnhancedHistoryEvent) {
+	if eh.treeBuilder == nil {
+		return
+	}
+
+	// A refresh may already have loaded some of these events
+	var lastEventID int64
+	if n := len(eh.enhancedEvents); n > 0 {
+		lastEventID = eh.enhancedEvents[n-1].ID
+	}
+	var fresh []temporal.EnhancedHistoryEvent
+	for _, ev := range events {
+		if ev.ID > lastEventID {
+			fresh = append(fresh, ev)
+		}
+	}
+	if len(fresh) == 0 {
+		return
+	}
+	first := len(eh.enhancedEvents) == 0
+
+	eh.enhancedEvents = append(eh.enhancedEvents, fresh...)
+	for _, ev := range fresh {
+		eh.events = append(eh.events, temporal.HistoryEvent{
 			ID:      ev.ID,
 			Type:    ev.Type,
 			Time:    ev.Time,
 			Details: ev.Details,
-		}
+		})
 	}
 
-	// Build tree nodes
-	eh.treeNodes = temporal.BuildEventTree(eh.enhancedEvents)
+	changed := eh.treeBuilder.Add(fresh)
+	eh.treeNodes = eh.treeBuilder.Nodes()
 
-	// Populate current view
-	eh.refreshCurrentView()
+	// The first events populate the views from scratch, selecting the first entry
+	if first {
+		eh.refreshCurrentView()
+		return
+	}
+
+	switch eh.viewMode {
+	case ViewModeList:
+		for _, ev := range fresh {
+			eh.addTableRow(ev)
+		}
+	case ViewModeTree:
+		eh.treeView.UpdateNodes(eh.treeNodes, changed)
+	case ViewModeTimeline:
+		eh.timelineView.UpdateNodes(eh.treeNodes)
+	}
+	if eh.sidePanelOn {
+		eh.refreshSidePanel()
+	}
 }
 
 func (eh *EventHistory) populateTable() {
@@ -312,16 +420,7 @@ func (eh *EventHistory) populateTable() {
 	eh.table.SetHeaders("ID", "TIME", "TYPE", "NAME", "DETAILS")
 
 	for _, ev := range eh.enhancedEvents {
-		icon := eventIcon(ev.Type)
-		color := eventColor(ev.Type)
-		name := getEventName(&ev)
-		eh.table.AddRowWithColor(color,
-			fmt.Sprintf("%d", ev.ID),
-			ev.Time.Format("15:04:05"),
-			icon+" "+ev.Type,
-			name,
-			truncate(ev.Details, 40),
-		)
+		eh.addTableRow(ev)
 	}
 
 	if eh.table.RowCount() > 0 {
@@ -338,6 +437,20 @@ func (eh *EventHistory) populateTable() {
 	}
 }
 
+// addTableRow appends a row for an event to the list view.
+func (eh *EventHistory) addTableRow(ev temporal.EnhancedHistoryEvent) {
+	icon := eventIcon(ev.Type)
+	color := eventColor(ev.Type)
+	name := getEventName(&ev)
+	eh.table.AddRowWithColor(color,
+		fmt.Sprintf("%d", ev.ID),
+		ev.Time.Format("15:04:05"),
+		icon+" "+ev.Type,
+		name,
+		truncate(ev.Details, 40),
+	)
+}
+
 // getEventName returns the activity type, timer ID, or child workflow type for an event.
 func getEventName(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ActivityType != "" {
@@ -349,6 +462,9 @@ func getEventName(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ChildWorkflowType != "" {
 		return ev.ChildWorkflowType
 	}
+	if ev.UpdateName != "" {
+		return "Update: " + ev.UpdateName
+	}
 	return ""
 }
 
@@ -428,6 +544,19 @@ func (eh *EventHistory) updateSidePanelFromList(index int) {
 	eh.sidePanel.SetText(text)
 }
 
+// openChildWorkflow pushes the detail view of the child workflow started by node.
+func (eh *EventHistory) openChildWorkflow(node *temporal.EventTreeNode) {
+	workflowID, runID := node.ChildWorkflow()
+	if workflowID == "" {
+		return
+	}
+	if runID == "" {
+		eh.app.ShowToastWarning(fmt.Sprintf("Child workflow %s has not started yet", workflowID))
+		return
+	}
+	eh.app.NavigateToWorkflowDetail(workflowID, runID)
+}
+
 func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 	if node == nil {
 		return
@@ -451,6 +580,10 @@ func (eh *EventHistory) updateSidePanelFromTree(node *temporal.EventTreeNode) {
 	// Extract result/failure from events
 	var dataStr string
 	for _, ev := range node.Events {
+		if ev.UpdateInput != "" {
+			formatted := formatSidePanelDetails(ev.UpdateInput)
+			dataStr += fmt.Sprintf("\n\n[%s::b]Input[-:-:-]\n%s", theme.TagAccent(), formatted)
+		}
 		if ev.Result != "" {
 			formatted := formatSidePanelDetails(ev.Result)
 			dataStr += fmt.Sprintf("\n\n[%s::b]Result[-:-:-]\n%s", theme.TagAccent(), formatted)
@@ -538,6 +671,15 @@ func (eh *EventHistory) setupInputCapture() {
 		case 'r':
 			eh.loadData()
 			return nil
+		case 'F':
+			eh.toggleFollow()
+			return nil
+		case 'x':
+			eh.stopLoading()
+			return nil
+		case 'E':
+			eh.app.showHistoryExport(eh.workflowID, eh.runID)
+			return nil
 		case 'y':
 			eh.yankEventData()
 			return nil
@@ -580,6 +722,9 @@ func (eh *EventHistory) setupInputCapture() {
 
 // Stop is called when the view is deactivated.
 func (eh *EventHistory) Stop() {
+	eh.loader.Stop()
+	eh.follower.Stop()
+	eh.followPending = false
 	eh.table.SetInputCapture(nil)
 	eh.treeView.SetInputCapture(nil)
 	eh.timelineView.SetInputCapture(nil)
@@ -594,6 +739,15 @@ func (eh *EventHistory) Hints() []KeyHint {
 		{Key: "y", Description: "Yank"},
 		{Key: "p", Description: "Preview"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "E", Description: "Export"},
+	}
+	if eh.loader.Active() {
+		hints = append(hints, KeyHint{Key: "x", Description: "Stop Loading"})
+	}
+	if eh.following() {
+		hints = append(hints, KeyHint{Key: "F", Description: "Stop Following"})
+	} else {
+		hints = append(hints, KeyHint{Key: "F", Description: "Follow"})
 	}
 
 	// Add view-specific hints
@@ -603,11 +757,13 @@ func (eh *EventHistory) Hints() []KeyHint {
 			KeyHint{Key: "e", Description: "Expand All"},
 			KeyHint{Key: "c", Description: "Collapse All"},
 			KeyHint{Key: "f", Description: "Jump to Failed"},
+			KeyHint{Key: "Enter", Description: "Open Child"},
 		)
 	case ViewModeTimeline:
 		hints = append(hints,
 			KeyHint{Key: "+/-", Description: "Zoom"},
 			KeyHint{Key: "h/l", Description: "Scroll"},
+			KeyHint{Key: "Enter", Description: "Open Child"},
 		)
 	}
 
@@ -746,6 +902,9 @@ func (eh *EventHistory) formatEventDataRaw(ev *temporal.EnhancedHistoryEvent) st
 	if ev.Details != "" {
 		parts = append(parts, fmt.Sprintf("Details: %s", prettyPrintJSON(ev.Details)))
 	}
+	if ev.UpdateInput != "" {
+		parts = append(parts, fmt.Sprintf("Input: %s", prettyPrintJSON(ev.UpdateInput)))
+	}
 	if ev.Result != "" {
 		parts = append(parts, fmt.Sprintf("Result: %s", prettyPrintJSON(ev.Result)))
 	}
@@ -0,0 +1,109 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+)
+
+// showHistoryExport prompts for a file and writes the workflow's raw history to it
+// in the temporal CLI's JSON format, ready for the SDK's WorkflowReplayer.
+func (a *App) showHistoryExport(workflowID, runID string) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Export History", theme.IconExport),
+		Width:    70,
+		Height:   12,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("path", "File", "")
+	_ = form.SetValues(map[string]any{"path": historyExportFilename(workflowID, runID)})
+
+	submit := func() {
+		path := strings.TrimSpace(form.GetValues()["path"].(string))
+		if path == "" {
+			return
+		}
+		a.closeHistoryExport()
+		a.exportHistory(workflowID, runID, path)
+	}
+	form.SetOnSubmit(func(map[string]any) { submit() })
+	form.SetOnCancel(a.closeHistoryExport)
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Export"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(submit)
+	modal.SetOnCancel(a.closeHistoryExport)
+
+	a.app.Pages().AddPage("history-export-form", modal, true, true)
+	a.app.SetFocus(form)
+}
+
+func (a *App) closeHistoryExport() {
+	a.app.Pages().RemovePage("history-export-form")
+	if current := a.app.Pages().Current(); current != nil {
+		a.app.SetFocus(current)
+	}
+}
+
+// exportHistory fetches the raw history and writes it to path.
+func (a *App) exportHistory(workflowID, runID, path string) {
+	provider := a.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := a.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cancel()
+
+		data, err := provider.ExportWorkflowHistory(ctx, namespace, workflowID, runID)
+		if err == nil {
+			err = os.WriteFile(expandHome(path), data, 0o644)
+		}
+
+		if err != nil {
+			a.ShowToastError(fmt.Sprintf("Export failed: %s", err.Error()))
+			return
+		}
+		a.ShowToastInfo(fmt.Sprintf("History exported to %s", path))
+	}()
+}
+
+// historyExportFilename returns a default file name for a workflow's history,
+// replacing characters that are not safe in file names.
+func historyExportFilename(workflowID, runID string) string {
+	name := workflowID
+	if runID != "" {
+		name += "_" + runID
+	}
+	name = strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ':', '*', '?', '"', '<', '>', '|', ' ':
+			return '_'
+		}
+		return r
+	}, name)
+	return name + "_history.json"
+}
+
+// expandHome replaces a leading ~/ with the user's home directory.
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/galaxy-io/tempo/internal/mock"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// historyFileNamespace is the namespace an opened history file is shown under.
+const historyFileNamespace = "history-file"
+
+// OpenHistoryFile shows a workflow history exported to JSON, without a server.
+// The file is served by an in-memory provider; the server provider is set aside
+// until a profile is selected.
+func (a *App) OpenHistoryFile(path string) error {
+	hf, err := temporal.LoadHistoryFile(expandHome(path))
+	if err != nil {
+		return err
+	}
+
+	if current := a.app.Pages().Current(); current != nil {
+		current.Stop()
+	}
+	if a.historyFile != "" {
+		a.provider.Close()
+	} else {
+		a.liveProvider = a.provider
+	}
+	a.provider = mock.NewProvider(mock.HistoryFixture(historyFileNamespace, hf))
+	a.historyFile = path
+	a.currentNS = historyFileNamespace
+
+	a.setProfile(filepath.Base(path))
+	a.setConnected(true)
+	a.setNamespace(historyFileNamespace)
+
+	a.reinitializeViews()
+	a.NavigateToWorkflows(historyFileNamespace)
+	a.NavigateToWorkflowDetail(hf.Workflow.ID, hf.Workflow.RunID)
+	return nil
+}
+
+// closeHistoryFile discards the opened history file and makes provider current again.
+func (a *App) closeHistoryFile(provider temporal.Provider) {
+	a.provider.Close()
+	a.provider = provider
+	a.historyFile = ""
+	a.liveProvider = nil
+}
+
+// handleOpenCommand handles `:open <file>` from the command bar.
+func (a *App) handleOpenCommand(path string) {
+	if path == "" {
+		return
+	}
+	if err := a.OpenHistoryFile(path); err != nil {
+		a.ShowToastError(fmt.Sprintf("Open failed: %s", err.Error()))
+	}
+}
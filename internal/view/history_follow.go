@@ -0,0 +1,99 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// followPollTimeout bounds a single long-poll; the server answers sooner when events arrive.
+const followPollTimeout = 60 * time.Second
+
+// historyFollower long-polls a workflow's history and delivers new events on the UI goroutine.
+type historyFollower struct {
+	app    *App
+	cancel context.CancelFunc
+}
+
+// Active reports whether the follower is running.
+func (hf *historyFollower) Active() bool {
+	return hf.cancel != nil
+}
+
+// Start begins following events after lastEventID. onEvents receives each batch of new
+// events; onDone is called once with nil when the workflow closes, or with the error
+// that stopped following. Neither is called after Stop.
+func (hf *historyFollower) Start(workflowID, runID string, lastEventID int64, onEvents func([]temporal.EnhancedHistoryEvent), onDone func(error)) {
+	provider := hf.app.Provider()
+	if provider == nil || hf.Active() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hf.cancel = cancel
+	namespace := hf.app.CurrentNamespace()
+
+	// finish runs on the UI goroutine, skipping callbacks if Stop won the race
+	finish := func(fn func()) {
+		hf.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() == nil {
+				fn()
+			}
+		})
+	}
+
+	go func() {
+		var token []byte
+		for {
+			pollCtx, pollCancel := context.WithTimeout(ctx, followPollTimeout)
+			page, err := provider.FollowWorkflowHistory(pollCtx, namespace, workflowID, runID, token)
+			timedOut := pollCtx.Err() == context.DeadlineExceeded
+			pollCancel()
+
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				// An idle long-poll that hit our deadline is retried with the same token
+				if timedOut {
+					continue
+				}
+				finish(func() {
+					hf.Stop()
+					onDone(err)
+				})
+				return
+			}
+
+			// The first read starts from the beginning; only deliver unseen events
+			var fresh []temporal.EnhancedHistoryEvent
+			for _, ev := range page.Events {
+				if ev.ID > lastEventID {
+					fresh = append(fresh, ev)
+					lastEventID = ev.ID
+				}
+			}
+			if len(fresh) > 0 {
+				finish(func() { onEvents(fresh) })
+			}
+
+			if len(page.NextPageToken) == 0 {
+				finish(func() {
+					hf.Stop()
+					onDone(nil)
+				})
+				return
+			}
+			token = page.NextPageToken
+		}
+	}()
+}
+
+// Stop ends following. Pending callbacks are dropped.
+func (hf *historyFollower) Stop() {
+	if hf.cancel != nil {
+		hf.cancel()
+		hf.cancel = nil
+	}
+}
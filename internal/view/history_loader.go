@@ -0,0 +1,142 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// historyPageTimeout bounds the fetch of a single history page.
+const historyPageTimeout = 30 * time.Second
+
+// historyLoader reads a workflow's history page by page and delivers each page on
+// the UI goroutine, so large histories render while the rest is still loading.
+type historyLoader struct {
+	app    *App
+	cancel context.CancelFunc
+
+	// Progress of the current load
+	events int
+	bytes  int
+	total  int64 // Expected event count, 0 if unknown
+}
+
+// Active reports whether a load is in progress.
+func (hl *historyLoader) Active() bool {
+	return hl.cancel != nil
+}
+
+// Start loads the history from the first page, replacing any load in progress.
+// onPage receives each page of events; onDone is called once with nil when the
+// whole history has loaded, or with the error that stopped it. Neither is
+// called after Stop.
+func (hl *historyLoader) Start(workflowID, runID string, onPage func([]temporal.EnhancedHistoryEvent), onDone func(error)) {
+	hl.Stop()
+	provider := hl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hl.cancel = cancel
+	hl.events, hl.bytes, hl.total = 0, 0, 0
+	namespace := hl.app.CurrentNamespace()
+
+	// deliver runs on the UI goroutine, skipping callbacks if Stop won the race
+	deliver := func(fn func()) {
+		hl.app.JigApp().QueueUpdateDraw(func() {
+			if ctx.Err() == nil {
+				fn()
+			}
+		})
+	}
+
+	// The expected event count is only used for progress, so failures are ignored
+	go func() {
+		descCtx, descCancel := context.WithTimeout(ctx, 10*time.Second)
+		defer descCancel()
+		if wf, err := provider.GetWorkflow(descCtx, namespace, workflowID, runID); err == nil {
+			deliver(func() { hl.total = wf.HistoryLength })
+		}
+	}()
+
+	go func() {
+		var token []byte
+		for {
+			pageCtx, pageCancel := context.WithTimeout(ctx, historyPageTimeout)
+			page, err := provider.GetWorkflowHistoryPage(pageCtx, namespace, workflowID, runID, token)
+			pageCancel()
+
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				deliver(func() {
+					hl.Stop()
+					onDone(err)
+				})
+				return
+			}
+
+			deliver(func() {
+				hl.events += len(page.Events)
+				hl.bytes += page.SizeBytes
+				onPage(page.Events)
+			})
+
+			if len(page.NextPageToken) == 0 {
+				deliver(func() {
+					hl.Stop()
+					onDone(nil)
+				})
+				return
+			}
+			token = page.NextPageToken
+		}
+	}()
+}
+
+// Stop cancels the load. Pages already delivered are kept by the caller.
+func (hl *historyLoader) Stop() {
+	if hl.cancel != nil {
+		hl.cancel()
+		hl.cancel = nil
+	}
+}
+
+// Progress describes how much of the history has loaded, e.g. "1,000 of 52,340 events, 2.1 MB".
+func (hl *historyLoader) Progress() string {
+	count := formatCount(int64(hl.events))
+	if hl.total > int64(hl.events) {
+		count += " of " + formatCount(hl.total)
+	}
+	return fmt.Sprintf("%s events, %s", count, formatBytes(int64(hl.bytes)))
+}
+
+// formatCount formats n with thousands separators.
+func formatCount(n int64) string {
+	s := fmt.Sprintf("%d", n)
+	if n < 0 {
+		return s
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// formatBytes formats a byte count using binary units.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
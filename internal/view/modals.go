@@ -3,6 +3,7 @@ package view
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/atterpac/jig/components"
 	"github.com/atterpac/jig/layout"
@@ -412,7 +413,7 @@ func NewProfileForm() *ProfileForm {
 		Modal: components.NewModal(components.ModalConfig{
 			Title:    fmt.Sprintf("%s New Profile", theme.IconInfo),
 			Width:    60,
-			Height:   22,
+			Height:   34,
 			Backdrop: true,
 		}),
 	}
@@ -425,11 +426,18 @@ func (f *ProfileForm) setup() {
 	f.form.AddTextField("name", "Profile Name", "")
 	f.form.AddTextField("address", "Server Address", "localhost:7233")
 	f.form.AddTextField("namespace", "Default Namespace", "default")
+	f.form.AddSelect("tlsEnabled", "Always Use TLS (system roots)", []string{"No", "Yes"})
 	f.form.AddTextField("tlsCert", "TLS Cert Path (optional)", "")
 	f.form.AddTextField("tlsKey", "TLS Key Path (optional)", "")
 	f.form.AddTextField("tlsCA", "TLS CA Path (optional)", "")
 	f.form.AddTextField("tlsServerName", "TLS Server Name (optional)", "")
 	f.form.AddSelect("tlsSkipVerify", "Skip TLS Verify", []string{"No", "Yes"})
+	f.form.AddTextField("codecEndpoint", "Codec Endpoint (optional)", "")
+	f.form.AddTextField("protoDescriptors", "Proto Descriptor Sets (comma-separated, optional)", "")
+	f.form.AddTextField("apiKey", "API Key (optional)", "")
+	f.form.AddTextField("apiKeyEnv", "API Key Env Var (optional)", "")
+	f.form.AddTextField("apiKeyCommand", "API Key Command (optional)", "")
+	f.form.AddTextField("headers", "gRPC Headers (key=value, comma-separated)", "")
 
 	f.form.SetOnSubmit(func(values map[string]any) {
 		name := values["name"].(string)
@@ -437,18 +445,9 @@ func (f *ProfileForm) setup() {
 			return
 		}
 
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
-
-		cfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
+		cfg, err := profileConfigFromValues(values)
+		if err != nil {
+			return
 		}
 
 		if f.onSave != nil {
@@ -474,18 +473,9 @@ func (f *ProfileForm) setup() {
 			return
 		}
 
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
-
-		cfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
+		cfg, err := profileConfigFromValues(values)
+		if err != nil {
+			return
 		}
 
 		if f.onSave != nil {
@@ -519,22 +509,36 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 	}
 	f.form.AddTextField("address", "Server Address", "localhost:7233")
 	f.form.AddTextField("namespace", "Default Namespace", "default")
+	f.form.AddSelect("tlsEnabled", "Always Use TLS (system roots)", []string{"No", "Yes"})
 	f.form.AddTextField("tlsCert", "TLS Cert Path (optional)", "")
 	f.form.AddTextField("tlsKey", "TLS Key Path (optional)", "")
 	f.form.AddTextField("tlsCA", "TLS CA Path (optional)", "")
 	f.form.AddTextField("tlsServerName", "TLS Server Name (optional)", "")
 
 	f.form.AddSelect("tlsSkipVerify", "Skip TLS Verify", []string{"No", "Yes"})
+	f.form.AddTextField("codecEndpoint", "Codec Endpoint (optional)", "")
+	f.form.AddTextField("protoDescriptors", "Proto Descriptor Sets (comma-separated, optional)", "")
+	f.form.AddTextField("apiKey", "API Key (optional)", "")
+	f.form.AddTextField("apiKeyEnv", "API Key Env Var (optional)", "")
+	f.form.AddTextField("apiKeyCommand", "API Key Command (optional)", "")
+	f.form.AddTextField("headers", "gRPC Headers (key=value, comma-separated)", "")
 
 	// Set actual values for editing (placeholders are just hints, values are the actual data)
 	values := map[string]any{
-		"address":       cfg.Address,
-		"namespace":     cfg.Namespace,
-		"tlsCert":       cfg.TLS.Cert,
-		"tlsKey":        cfg.TLS.Key,
-		"tlsCA":         cfg.TLS.CA,
-		"tlsServerName": cfg.TLS.ServerName,
-		"tlsSkipVerify": map[bool]string{true: "Yes", false: "No"}[cfg.TLS.SkipVerify],
+		"address":          cfg.Address,
+		"namespace":        cfg.Namespace,
+		"tlsEnabled":       map[bool]string{true: "Yes", false: "No"}[cfg.TLS.Enabled],
+		"tlsCert":          cfg.TLS.Cert,
+		"tlsKey":           cfg.TLS.Key,
+		"tlsCA":            cfg.TLS.CA,
+		"tlsServerName":    cfg.TLS.ServerName,
+		"tlsSkipVerify":    map[bool]string{true: "Yes", false: "No"}[cfg.TLS.SkipVerify],
+		"codecEndpoint":    cfg.CodecEndpoint,
+		"protoDescriptors": strings.Join(cfg.ProtoDescriptors, ", "),
+		"apiKey":           cfg.APIKey,
+		"apiKeyEnv":        cfg.APIKeyEnv,
+		"apiKeyCommand":    cfg.APIKeyCommand,
+		"headers":          config.FormatHeaders(cfg.Headers),
 	}
 	if f.isEdit {
 		values["name"] = name
@@ -550,18 +554,9 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 			return
 		}
 
-		skipVerify := values["tlsSkipVerify"].(string) == "Yes"
-
-		newCfg := config.ConnectionConfig{
-			Address:   values["address"].(string),
-			Namespace: values["namespace"].(string),
-			TLS: config.TLSConfig{
-				Cert:       values["tlsCert"].(string),
-				Key:        values["tlsKey"].(string),
-				CA:         values["tlsCA"].(string),
-				ServerName: values["tlsServerName"].(string),
-				SkipVerify: skipVerify,
-			},
+		newCfg, err := profileConfigFromValues(values)
+		if err != nil {
+			return
 		}
 
 		if f.onSave != nil {
@@ -577,6 +572,34 @@ func (f *ProfileForm) SetProfile(name string, cfg config.ConnectionConfig) {
 	f.Modal.SetContent(f.form)
 }
 
+// profileConfigFromValues builds a connection config from profile form values.
+// Malformed headers return an error so the form stays open for correction.
+func profileConfigFromValues(values map[string]any) (config.ConnectionConfig, error) {
+	headers, err := config.ParseHeaders(values["headers"].(string))
+	if err != nil {
+		return config.ConnectionConfig{}, err
+	}
+
+	return config.ConnectionConfig{
+		Address:   values["address"].(string),
+		Namespace: values["namespace"].(string),
+		TLS: config.TLSConfig{
+			Enabled:    values["tlsEnabled"].(string) == "Yes",
+			Cert:       values["tlsCert"].(string),
+			Key:        values["tlsKey"].(string),
+			CA:         values["tlsCA"].(string),
+			ServerName: values["tlsServerName"].(string),
+			SkipVerify: values["tlsSkipVerify"].(string) == "Yes",
+		},
+		CodecEndpoint:    values["codecEndpoint"].(string),
+		ProtoDescriptors: config.SplitList(values["protoDescriptors"].(string)),
+		APIKey:           values["apiKey"].(string),
+		APIKeyEnv:        values["apiKeyEnv"].(string),
+		APIKeyCommand:    values["apiKeyCommand"].(string),
+		Headers:          headers,
+	}, nil
+}
+
 func (f *ProfileForm) SetOnSave(fn func(string, config.ConnectionConfig)) { f.onSave = fn }
 func (f *ProfileForm) SetOnCancel(fn func())                              { f.onCancel = fn }
 
@@ -22,13 +22,17 @@ type NamespaceDetail struct {
 	detail    *temporal.NamespaceDetail
 	loading   bool
 
+	searchAttributes []temporal.SearchAttributeInfo
+
 	// UI components
 	infoPanel     *components.Panel
 	archivalPanel *components.Panel
 	clusterPanel  *components.Panel
+	attrPanel     *components.Panel
 	infoView      *tview.TextView
 	archivalView  *tview.TextView
 	clusterView   *tview.TextView
+	attrTable     *components.Table
 }
 
 // NewNamespaceDetail creates a new namespace detail view.
@@ -63,6 +67,11 @@ func (nd *NamespaceDetail) setup() {
 		SetTextAlign(tview.AlignLeft)
 	nd.clusterView.SetBackgroundColor(theme.Bg())
 
+	// Search attributes table
+	nd.attrTable = components.NewTable()
+	nd.attrTable.SetHeaders("NAME", "TYPE", "KIND")
+	nd.attrTable.SetBackgroundColor(theme.Bg())
+
 	// Create panels with icons (blubber pattern)
 	nd.infoPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Namespace Info", theme.IconNamespace))
 	nd.infoPanel.SetContent(nd.infoView)
@@ -73,10 +82,14 @@ func (nd *NamespaceDetail) setup() {
 	nd.clusterPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Cluster & Replication", theme.IconServer))
 	nd.clusterPanel.SetContent(nd.clusterView)
 
-	// Left side: Info panel
+	nd.attrPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Search Attributes", theme.IconSearch))
+	nd.attrPanel.SetContent(nd.attrTable)
+
+	// Left side: Info + Search attributes stacked
 	leftFlex := tview.NewFlex().SetDirection(tview.FlexRow)
 	leftFlex.SetBackgroundColor(theme.Bg())
-	leftFlex.AddItem(nd.infoPanel, 0, 2, false)
+	leftFlex.AddItem(nd.infoPanel, 0, 1, false)
+	leftFlex.AddItem(nd.attrPanel, 0, 2, true)
 
 	// Right side: Archival + Cluster stacked
 	rightFlex := tview.NewFlex().SetDirection(tview.FlexRow)
@@ -95,7 +108,6 @@ func (nd *NamespaceDetail) setup() {
 func (nd *NamespaceDetail) loadData() {
 	provider := nd.app.Provider()
 	if provider == nil {
-		nd.loadMockData()
 		return
 	}
 
@@ -116,25 +128,8 @@ func (nd *NamespaceDetail) loadData() {
 			nd.render()
 		})
 	}()
-}
 
-func (nd *NamespaceDetail) loadMockData() {
-	nd.detail = &temporal.NamespaceDetail{
-		Namespace: temporal.Namespace{
-			Name:            nd.namespace,
-			State:           "Active",
-			RetentionPeriod: "30 days",
-			Description:     "Mock namespace for development",
-			OwnerEmail:      "dev@example.com",
-		},
-		ID:                 "mock-namespace-id-12345",
-		IsGlobalNamespace:  false,
-		FailoverVersion:    0,
-		HistoryArchival:    "Disabled",
-		VisibilityArchival: "Disabled",
-		Clusters:           []string{"active"},
-	}
-	nd.render()
+	nd.loadSearchAttributes()
 }
 
 func (nd *NamespaceDetail) showError(err error) {
@@ -154,9 +149,11 @@ func (nd *NamespaceDetail) RefreshTheme() {
 	nd.infoView.SetBackgroundColor(bg)
 	nd.archivalView.SetBackgroundColor(bg)
 	nd.clusterView.SetBackgroundColor(bg)
+	nd.attrTable.SetBackgroundColor(bg)
 
 	// Re-render content with new theme colors
 	nd.render()
+	nd.populateSearchAttributes()
 }
 
 func (nd *NamespaceDetail) render() {
@@ -271,6 +268,12 @@ func (nd *NamespaceDetail) Start() {
 		case 'D':
 			nd.showDeprecateConfirm()
 			return nil
+		case 'a':
+			nd.showAddSearchAttributeForm()
+			return nil
+		case 'x':
+			nd.showRemoveSearchAttributeConfirm()
+			return nil
 		}
 		return event
 	})
@@ -287,6 +290,8 @@ func (nd *NamespaceDetail) Hints() []KeyHint {
 	hints := []KeyHint{
 		{Key: "r", Description: "Refresh"},
 		{Key: "e", Description: "Edit"},
+		{Key: "a", Description: "Add Attribute"},
+		{Key: "x", Description: "Remove Attribute"},
 	}
 
 	// Only show deprecate for active namespaces
@@ -304,7 +309,7 @@ func (nd *NamespaceDetail) Hints() []KeyHint {
 
 // Focus sets focus to this view.
 func (nd *NamespaceDetail) Focus(delegate func(p tview.Primitive)) {
-	delegate(nd.Flex)
+	delegate(nd.attrTable)
 }
 
 // Draw applies theme colors dynamically and draws the view.
@@ -314,6 +319,7 @@ func (nd *NamespaceDetail) Draw(screen tcell.Screen) {
 	nd.infoView.SetBackgroundColor(bg)
 	nd.archivalView.SetBackgroundColor(bg)
 	nd.clusterView.SetBackgroundColor(bg)
+	nd.attrTable.SetBackgroundColor(bg)
 	nd.Flex.Draw(screen)
 }
 
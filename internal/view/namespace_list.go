@@ -22,6 +22,7 @@ type NamespaceList struct {
 	emptyState    *components.EmptyState
 	app           *App
 	namespaces    []temporal.Namespace
+	workflowStats map[string]WorkflowStats // Workflow counts by namespace, filled in after the list loads
 	loading       bool
 	autoRefresh   bool
 	showPreview   bool
@@ -45,7 +46,7 @@ func NewNamespaceList(app *App) *NamespaceList {
 }
 
 func (nl *NamespaceList) setup() {
-	nl.table.SetHeaders("NAME", "STATE", "RETENTION")
+	nl.table.SetHeaders("NAME", "STATE", "RETENTION", "RUNNING", "FAILED")
 	nl.table.SetBorder(false)
 	nl.table.SetBackgroundColor(theme.Bg())
 	nl.SetBackgroundColor(theme.Bg())
@@ -138,6 +139,9 @@ func (nl *NamespaceList) updatePreview(ns temporal.Namespace) {
 [%s::b]Retention[-:-:-]
   [%s]%s[-]
 
+[%s::b]Workflows[-:-:-]
+  %s
+
 [%s::b]Description[-:-:-]
   [%s]%s[-]
 
@@ -150,6 +154,8 @@ func (nl *NamespaceList) updatePreview(ns temporal.Namespace) {
 		theme.TagFgDim(),
 		theme.TagFg(), ns.RetentionPeriod,
 		theme.TagFgDim(),
+		nl.formatWorkflowStats(ns.Name),
+		theme.TagFgDim(),
 		theme.TagFg(), valueOrEmpty(ns.Description, "No description"),
 		theme.TagFgDim(),
 		theme.TagFg(), valueOrEmpty(ns.OwnerEmail, "No owner"),
@@ -171,7 +177,6 @@ func (nl *NamespaceList) setLoading(loading bool) {
 func (nl *NamespaceList) loadData() {
 	provider := nl.app.Provider()
 	if provider == nil {
-		nl.loadMockData()
 		return
 	}
 
@@ -190,26 +195,61 @@ func (nl *NamespaceList) loadData() {
 			}
 			nl.namespaces = namespaces
 			nl.populateTable()
+			nl.loadWorkflowStats(namespaces)
 		})
 	}()
 }
 
-func (nl *NamespaceList) loadMockData() {
-	nl.namespaces = []temporal.Namespace{
-		{Name: "default", State: "Active", RetentionPeriod: "7 days"},
-		{Name: "production", State: "Active", RetentionPeriod: "30 days"},
-		{Name: "staging", State: "Active", RetentionPeriod: "3 days"},
-		{Name: "development", State: "Active", RetentionPeriod: "1 day"},
-		{Name: "archived", State: "Deprecated", RetentionPeriod: "90 days"},
+// loadWorkflowStats counts each namespace's workflows by status.
+func (nl *NamespaceList) loadWorkflowStats(namespaces []temporal.Namespace) {
+	provider := nl.app.Provider()
+	if provider == nil {
+		return
 	}
-	nl.populateTable()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		stats := make(map[string]WorkflowStats, len(namespaces))
+		for _, ns := range namespaces {
+			_, groups, err := provider.CountWorkflows(ctx, ns.Name, "", "ExecutionStatus")
+			if err != nil {
+				continue // Left blank; the namespace may not allow counting
+			}
+			var s WorkflowStats
+			for _, g := range groups {
+				s.add(g.Value, g.Count)
+			}
+			stats[ns.Name] = s
+		}
+
+		nl.app.JigApp().QueueUpdateDraw(func() {
+			nl.workflowStats = stats
+			nl.populateTable()
+		})
+	}()
+}
+
+// formatWorkflowStats summarizes a namespace's workflow counts for the preview.
+func (nl *NamespaceList) formatWorkflowStats(namespace string) string {
+	s, ok := nl.workflowStats[namespace]
+	if !ok {
+		return fmt.Sprintf("[%s]-[-]", theme.TagFgMuted())
+	}
+	return fmt.Sprintf("[%s]%s running[-], [%s]%s completed[-], [%s]%s failed[-], [%s]%s timed out[-], [%s]%s other[-]",
+		theme.TagInfo(), formatCount(s.Running),
+		theme.TagSuccess(), formatCount(s.Completed),
+		theme.TagError(), formatCount(s.Failed),
+		theme.TagWarning(), formatCount(s.TimedOut),
+		theme.TagFgMuted(), formatCount(s.Other))
 }
 
 func (nl *NamespaceList) populateTable() {
 	currentRow := nl.table.SelectedRow()
 
 	nl.table.ClearRows()
-	nl.table.SetHeaders("NAME", "STATE", "RETENTION")
+	nl.table.SetHeaders("NAME", "STATE", "RETENTION", "RUNNING", "FAILED")
 
 	if len(nl.namespaces) == 0 {
 		nl.leftPanel.SetContent(nl.emptyState)
@@ -220,10 +260,16 @@ func (nl *NamespaceList) populateTable() {
 	nl.leftPanel.SetContent(nl.table)
 
 	for _, ns := range nl.namespaces {
+		running, failed := "-", "-"
+		if s, ok := nl.workflowStats[ns.Name]; ok {
+			running, failed = formatCount(s.Running), formatCount(s.Failed)
+		}
 		nl.table.AddStyledRowSimple(ns.State,
 			theme.IconDatabase+" "+ns.Name,
 			ns.State,
 			ns.RetentionPeriod,
+			running,
+			failed,
 		)
 	}
 
@@ -242,11 +288,13 @@ func (nl *NamespaceList) populateTable() {
 
 func (nl *NamespaceList) showError(err error) {
 	nl.table.ClearRows()
-	nl.table.SetHeaders("NAME", "STATE", "RETENTION")
+	nl.table.SetHeaders("NAME", "STATE", "RETENTION", "RUNNING", "FAILED")
 	nl.table.AddRowWithColor(theme.Error(),
 		theme.IconError+" Error loading namespaces",
 		err.Error(),
 		"",
+		"",
+		"",
 	)
 }
 
@@ -0,0 +1,260 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+func (nd *NamespaceDetail) loadSearchAttributes() {
+	provider := nd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		attrs, err := provider.ListSearchAttributes(ctx, nd.namespace)
+
+		nd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				nd.searchAttributes = nil
+				nd.attrTable.ClearRows()
+				nd.attrTable.SetHeaders("NAME", "TYPE", "KIND")
+				nd.attrTable.AddRowWithColor(theme.Error(),
+					theme.IconError+" Error loading search attributes",
+					err.Error(),
+					"",
+				)
+				return
+			}
+			// Custom attributes first, since those are the ones that can be removed
+			slices.SortStableFunc(attrs, func(a, b temporal.SearchAttributeInfo) int {
+				switch {
+				case a.System == b.System:
+					return 0
+				case b.System:
+					return -1
+				default:
+					return 1
+				}
+			})
+			nd.searchAttributes = attrs
+			nd.populateSearchAttributes()
+		})
+	}()
+}
+
+func (nd *NamespaceDetail) populateSearchAttributes() {
+	// Preserve current selection
+	currentRow := nd.attrTable.SelectedRow()
+
+	nd.attrTable.ClearRows()
+	nd.attrTable.SetHeaders("NAME", "TYPE", "KIND")
+
+	if len(nd.searchAttributes) == 0 {
+		return
+	}
+
+	custom := 0
+	for _, attr := range nd.searchAttributes {
+		kind, color := "Custom", theme.Fg()
+		if attr.System {
+			kind, color = "System", theme.FgDim()
+		} else {
+			custom++
+		}
+		nd.attrTable.AddRowWithColor(color, attr.Name, attr.Type, kind)
+	}
+	nd.attrPanel.SetTitle(fmt.Sprintf("%s Search Attributes (%d custom, %d system)",
+		theme.IconSearch, custom, len(nd.searchAttributes)-custom))
+
+	if currentRow >= 0 && currentRow < len(nd.searchAttributes) {
+		nd.attrTable.SelectRow(currentRow)
+	} else {
+		nd.attrTable.SelectRow(0)
+	}
+}
+
+func (nd *NamespaceDetail) selectedSearchAttribute() (temporal.SearchAttributeInfo, bool) {
+	row := nd.attrTable.SelectedRow()
+	if row < 0 || row >= len(nd.searchAttributes) {
+		return temporal.SearchAttributeInfo{}, false
+	}
+	return nd.searchAttributes[row], true
+}
+
+func (nd *NamespaceDetail) showAddSearchAttributeForm() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Add Search Attribute", theme.IconSearch),
+		Width:    60,
+		Height:   12,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("name", "Name", "e.g. CustomerId")
+	form.AddSelect("type", "Type", temporal.SearchAttributeTypes)
+
+	submit := func(values map[string]any) {
+		name := strings.TrimSpace(values["name"].(string))
+		if name == "" || strings.ContainsAny(name, " \t") {
+			nd.app.ShowToastWarning("Search attribute name must be a single word")
+			return
+		}
+		for _, attr := range nd.searchAttributes {
+			if attr.Name == name {
+				nd.app.ShowToastWarning(fmt.Sprintf("Search attribute %s already exists", name))
+				return
+			}
+		}
+		nd.closeModal("search-attribute-form")
+		nd.executeAddSearchAttribute(name, values["type"].(string))
+	}
+
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		nd.closeModal("search-attribute-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Add"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		nd.closeModal("search-attribute-form")
+	})
+
+	nd.app.JigApp().Pages().AddPage("search-attribute-form", modal, true, true)
+	nd.app.JigApp().SetFocus(form)
+}
+
+func (nd *NamespaceDetail) executeAddSearchAttribute(name, typ string) {
+	provider := nd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := provider.AddSearchAttributes(ctx, nd.namespace, map[string]string{name: typ})
+
+		nd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				nd.app.ShowToastError(err.Error())
+				return
+			}
+			nd.app.SearchAttributesChanged()
+			nd.app.ShowToastInfo(fmt.Sprintf("Added search attribute %s (%s)", name, typ))
+			nd.loadSearchAttributes()
+		})
+	}()
+}
+
+func (nd *NamespaceDetail) showRemoveSearchAttributeConfirm() {
+	attr, ok := nd.selectedSearchAttribute()
+	if !ok {
+		return
+	}
+	if attr.System {
+		nd.app.ShowToastWarning(fmt.Sprintf("%s is a system search attribute and can't be removed", attr.Name))
+		return
+	}
+
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Remove Search Attribute", theme.IconError),
+		Width:    70,
+		Height:   16,
+		Backdrop: true,
+	})
+
+	contentFlex := tview.NewFlex().SetDirection(tview.FlexRow)
+	contentFlex.SetBackgroundColor(theme.Bg())
+
+	warningText := tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	warningText.SetBackgroundColor(theme.Bg())
+	warningText.SetText(fmt.Sprintf(`[%s]Warning: Removing a search attribute has the following effects:[-]
+
+• Workflows can no longer be filtered or sorted by it
+• Workflows that set it will fail to start or upsert it
+
+[%s]Attribute:[-] [%s]%s[-] [%s](%s)[-]`,
+		theme.TagError(),
+		theme.TagFgDim(), theme.TagFg(), attr.Name, theme.TagFgMuted(), attr.Type))
+
+	form := components.NewForm()
+	form.AddTextField("confirm", "Type attribute name to confirm", "")
+
+	submit := func(values map[string]any) {
+		if values["confirm"].(string) != attr.Name {
+			return // Must match attribute name
+		}
+		nd.closeModal("remove-search-attribute-confirm")
+		nd.executeRemoveSearchAttribute(attr.Name)
+	}
+
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		nd.closeModal("remove-search-attribute-confirm")
+	})
+
+	contentFlex.AddItem(warningText, 7, 0, false)
+	contentFlex.AddItem(form, 0, 1, true)
+
+	modal.SetContent(contentFlex)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Enter", Description: "Remove"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		nd.closeModal("remove-search-attribute-confirm")
+	})
+
+	nd.app.JigApp().Pages().AddPage("remove-search-attribute-confirm", modal, true, true)
+	nd.app.JigApp().SetFocus(form)
+}
+
+func (nd *NamespaceDetail) executeRemoveSearchAttribute(name string) {
+	provider := nd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		err := provider.RemoveSearchAttributes(ctx, nd.namespace, []string{name})
+
+		nd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				nd.app.ShowToastError(err.Error())
+				return
+			}
+			nd.app.SearchAttributesChanged()
+			nd.app.ShowToastInfo(fmt.Sprintf("Removed search attribute %s", name))
+			nd.loadSearchAttributes()
+		})
+	}()
+}
@@ -0,0 +1,324 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// showCreateSchedule opens an empty schedule form.
+func (sl *ScheduleList) showCreateSchedule() {
+	sl.showScheduleForm(nil)
+}
+
+// showEditSchedule loads the selected schedule's full definition and opens it in the form.
+func (sl *ScheduleList) showEditSchedule() {
+	schedule := sl.getSelectedSchedule()
+	if schedule == nil {
+		return
+	}
+	provider := sl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		s, err := provider.GetSchedule(ctx, sl.namespace, schedule.ID)
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				sl.app.ShowToastError(err.Error())
+				return
+			}
+			// Saving a partial definition would drop whatever is missing from it
+			if s.Definition == nil {
+				sl.app.ShowToastError(fmt.Sprintf("%s can't be edited here: its spec uses settings the editor can't represent", s.ID))
+				return
+			}
+			sl.showScheduleForm(s.Definition)
+		})
+	}()
+}
+
+// showScheduleForm displays the schedule editor, creating a schedule when existing is nil
+// and replacing existing's definition otherwise.
+func (sl *ScheduleList) showScheduleForm(existing *temporal.ScheduleDefinition) {
+	title := fmt.Sprintf("%s New Schedule (%s)", theme.IconSchedule, sl.namespace)
+	if existing != nil {
+		title = fmt.Sprintf("%s Edit Schedule %s", theme.IconSchedule, existing.ID)
+	}
+	modal := components.NewModal(components.ModalConfig{
+		Title:    title,
+		Width:    90,
+		Height:   36,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	if existing == nil {
+		form.AddTextField("id", "Schedule ID", "")
+	}
+	form.AddTextField("cron", "Cron Expressions (; separated)", "e.g. 0 9 * * MON-FRI")
+	form.AddTextField("intervals", "Intervals (every[/offset]; separated)", "e.g. 1h or 1h/15m")
+	form.AddTextField("calendars", "Calendars (; separated)", "e.g. dayOfWeek=mon-fri hour=9 minute=30")
+	form.AddTextField("jitter", "Jitter (e.g. 30s, optional)", "")
+	form.AddTextField("timeZone", "Time Zone (blank = UTC)", "e.g. America/New_York")
+	form.AddTextField("startTime", "Start Time (RFC3339, optional)", "e.g. 2026-01-01T00:00:00Z")
+	form.AddTextField("endTime", "End Time (RFC3339, optional)", "")
+	form.AddSelect("overlap", "Overlap Policy", temporal.ScheduleOverlapPolicies)
+	form.AddTextField("catchupWindow", "Catchup Window (e.g. 10m, optional)", "")
+	form.AddCheckbox("pauseOnFailure", "Pause on failure")
+	form.AddTextField("workflowType", "Workflow Type", "")
+	form.AddTextField("workflowId", "Workflow ID (blank = schedule ID)", "")
+	form.AddTextField("taskQueue", "Task Queue", "")
+	form.AddTextField("input", "Input (JSON, optional)", "")
+	form.AddTextField("memo", "Memo (JSON object, optional)", "")
+	form.AddTextField("searchAttributes", "Search Attributes (JSON object, optional)", "")
+	form.AddTextField("notes", "Notes", "")
+	form.AddCheckbox("paused", "Paused")
+
+	if existing != nil {
+		form.SetValues(scheduleFormValues(*existing))
+	}
+
+	submit := func(values map[string]any) {
+		if existing != nil {
+			values["id"] = existing.ID
+		}
+		def, err := buildScheduleDefinition(values)
+		if err != nil {
+			sl.app.ShowToastError(err.Error())
+			return
+		}
+		if existing != nil {
+			def.ConflictToken = existing.ConflictToken
+		}
+		if err := def.Validate(); err != nil {
+			sl.app.ShowToastWarning(err.Error())
+			return
+		}
+
+		sl.closeModal("schedule-form")
+		sl.executeSaveSchedule(def, existing == nil)
+	}
+
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Save"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		sl.closeModal("schedule-form")
+	})
+
+	sl.app.JigApp().Pages().AddPage("schedule-form", modal, true, true)
+	sl.app.JigApp().SetFocus(form)
+}
+
+// scheduleFormValues fills the schedule form from a definition.
+func scheduleFormValues(def temporal.ScheduleDefinition) map[string]any {
+	var intervals, calendars []string
+	for _, interval := range def.Spec.Intervals {
+		intervals = append(intervals, interval.String())
+	}
+	for _, cal := range def.Spec.Calendars {
+		calendars = append(calendars, cal.String())
+	}
+	formatTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.RFC3339)
+	}
+	formatDuration := func(d time.Duration) string {
+		if d == 0 {
+			return ""
+		}
+		return temporal.FormatScheduleDuration(d)
+	}
+	formatJSON := func(obj map[string]any) string {
+		if len(obj) == 0 {
+			return ""
+		}
+		data, _ := json.Marshal(obj)
+		return string(data)
+	}
+
+	values := map[string]any{
+		"cron":             strings.Join(def.Spec.CronExpressions, "; "),
+		"intervals":        strings.Join(intervals, "; "),
+		"calendars":        strings.Join(calendars, "; "),
+		"jitter":           formatDuration(def.Spec.Jitter),
+		"timeZone":         def.Spec.TimeZone,
+		"startTime":        formatTime(def.Spec.StartTime),
+		"endTime":          formatTime(def.Spec.EndTime),
+		"catchupWindow":    formatDuration(def.Policy.CatchupWindow),
+		"pauseOnFailure":   def.Policy.PauseOnFailure,
+		"workflowType":     def.Action.WorkflowType,
+		"workflowId":       def.Action.WorkflowID,
+		"taskQueue":        def.Action.TaskQueue,
+		"input":            string(def.Action.Input),
+		"memo":             formatJSON(def.Action.Memo),
+		"searchAttributes": formatJSON(def.Action.SearchAttributes),
+		"notes":            def.Notes,
+		"paused":           def.Paused,
+	}
+	if def.Policy.Overlap != "" {
+		values["overlap"] = def.Policy.Overlap
+	}
+	return values
+}
+
+// buildScheduleDefinition converts schedule form values into a definition,
+// validating the spec, durations, times and JSON fields.
+func buildScheduleDefinition(values map[string]any) (temporal.ScheduleDefinition, error) {
+	def := temporal.ScheduleDefinition{
+		ID: strings.TrimSpace(values["id"].(string)),
+		Spec: temporal.ScheduleSpec{
+			TimeZone: strings.TrimSpace(values["timeZone"].(string)),
+		},
+		Action: temporal.ScheduleAction{
+			WorkflowType: strings.TrimSpace(values["workflowType"].(string)),
+			WorkflowID:   strings.TrimSpace(values["workflowId"].(string)),
+			TaskQueue:    strings.TrimSpace(values["taskQueue"].(string)),
+		},
+		Policy: temporal.SchedulePolicy{
+			Overlap:        values["overlap"].(string),
+			PauseOnFailure: values["pauseOnFailure"].(bool),
+		},
+		Paused: values["paused"].(bool),
+		Notes:  strings.TrimSpace(values["notes"].(string)),
+	}
+
+	def.Spec.CronExpressions = splitScheduleList(values["cron"].(string))
+	for _, text := range splitScheduleList(values["intervals"].(string)) {
+		interval, err := temporal.ParseScheduleInterval(text)
+		if err != nil {
+			return def, err
+		}
+		def.Spec.Intervals = append(def.Spec.Intervals, interval)
+	}
+	for _, text := range splitScheduleList(values["calendars"].(string)) {
+		cal, err := temporal.ParseScheduleCalendar(text)
+		if err != nil {
+			return def, err
+		}
+		def.Spec.Calendars = append(def.Spec.Calendars, cal)
+	}
+
+	durations := []struct {
+		field string
+		label string
+		dest  *time.Duration
+	}{
+		{"jitter", "jitter", &def.Spec.Jitter},
+		{"catchupWindow", "catchup window", &def.Policy.CatchupWindow},
+	}
+	for _, d := range durations {
+		text := strings.TrimSpace(values[d.field].(string))
+		if text == "" {
+			continue
+		}
+		parsed, err := time.ParseDuration(text)
+		if err != nil || parsed < 0 {
+			return def, fmt.Errorf("invalid %s: %s", d.label, text)
+		}
+		*d.dest = parsed
+	}
+
+	times := []struct {
+		field string
+		label string
+		dest  **time.Time
+	}{
+		{"startTime", "start time", &def.Spec.StartTime},
+		{"endTime", "end time", &def.Spec.EndTime},
+	}
+	for _, t := range times {
+		text := strings.TrimSpace(values[t.field].(string))
+		if text == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, text)
+		if err != nil {
+			return def, fmt.Errorf("invalid %s (expected RFC3339): %s", t.label, text)
+		}
+		*t.dest = &parsed
+	}
+
+	if input := strings.TrimSpace(values["input"].(string)); input != "" {
+		if !json.Valid([]byte(input)) {
+			return def, fmt.Errorf("input is not valid JSON")
+		}
+		def.Action.Input = []byte(input)
+	}
+
+	var err error
+	if def.Action.Memo, err = parseJSONObject(values["memo"].(string)); err != nil {
+		return def, fmt.Errorf("memo: %w", err)
+	}
+	if def.Action.SearchAttributes, err = parseJSONObject(values["searchAttributes"].(string)); err != nil {
+		return def, fmt.Errorf("search attributes: %w", err)
+	}
+	return def, nil
+}
+
+// splitScheduleList splits a ";"-separated form field, dropping blank entries.
+func splitScheduleList(text string) []string {
+	var items []string
+	for _, item := range strings.Split(text, ";") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// executeSaveSchedule creates or updates the schedule asynchronously.
+func (sl *ScheduleList) executeSaveSchedule(def temporal.ScheduleDefinition, create bool) {
+	provider := sl.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var err error
+		verb := "Updated"
+		if create {
+			err = provider.CreateSchedule(ctx, sl.namespace, def)
+			verb = "Created"
+		} else {
+			err = provider.UpdateSchedule(ctx, sl.namespace, def)
+		}
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				sl.app.ShowToastError(err.Error())
+				return
+			}
+			sl.app.ShowToastInfo(fmt.Sprintf("%s schedule %s", verb, def.ID))
+			sl.loadData()
+		})
+	}()
+}
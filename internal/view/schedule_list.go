@@ -24,8 +24,13 @@ type ScheduleList struct {
 	schedules   []temporal.Schedule
 	loading     bool
 	showPreview bool
+
+	nextPageToken string // Token for the page after schedules; empty once all are loaded
+	loadingMore   bool
 }
 
+const schedulePageSize = 100
+
 // NewScheduleList creates a new schedule list view.
 func NewScheduleList(app *App, namespace string) *ScheduleList {
 	sl := &ScheduleList{
@@ -65,6 +70,9 @@ func (sl *ScheduleList) setup() {
 		if row > 0 && row-1 < len(sl.schedules) {
 			sl.updatePreview(sl.schedules[row-1])
 		}
+		if row > len(sl.schedules)-loadMoreThreshold {
+			sl.loadMore()
+		}
 	})
 
 	sl.buildLayout()
@@ -167,7 +175,6 @@ func (sl *ScheduleList) updatePreview(s temporal.Schedule) {
 func (sl *ScheduleList) loadData() {
 	provider := sl.app.Provider()
 	if provider == nil {
-		sl.loadMockData()
 		return
 	}
 
@@ -176,7 +183,7 @@ func (sl *ScheduleList) loadData() {
 		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 		defer cancel()
 
-		schedules, _, err := provider.ListSchedules(ctx, sl.namespace, temporal.ListOptions{PageSize: 100})
+		schedules, nextToken, err := provider.ListSchedules(ctx, sl.namespace, temporal.ListOptions{PageSize: schedulePageSize})
 
 		sl.app.JigApp().QueueUpdateDraw(func() {
 			sl.loading = false
@@ -185,48 +192,61 @@ func (sl *ScheduleList) loadData() {
 				return
 			}
 			sl.schedules = schedules
+			sl.nextPageToken = nextToken
 			sl.populateTable()
+			sl.updatePanelTitle()
 		})
 	}()
 }
 
-func (sl *ScheduleList) loadMockData() {
-	now := time.Now()
-	nextRun := now.Add(5 * time.Minute)
-	lastRun := now.Add(-1 * time.Hour)
-	sl.schedules = []temporal.Schedule{
-		{
-			ID:           "daily-report",
-			WorkflowType: "ReportWorkflow",
-			Spec:         "0 9 * * *",
-			Paused:       false,
-			NextRunTime:  &nextRun,
-			LastRunTime:  &lastRun,
-			TotalActions: 365,
-			Notes:        "Daily report generation",
-		},
-		{
-			ID:           "hourly-cleanup",
-			WorkflowType: "CleanupWorkflow",
-			Spec:         "every 1h",
-			Paused:       false,
-			NextRunTime:  &nextRun,
-			LastRunTime:  &lastRun,
-			TotalActions: 2190,
-			Notes:        "Hourly cleanup tasks",
-		},
-		{
-			ID:           "weekly-backup",
-			WorkflowType: "BackupWorkflow",
-			Spec:         "0 0 * * 0",
-			Paused:       true,
-			NextRunTime:  nil,
-			LastRunTime:  &lastRun,
-			TotalActions: 52,
-			Notes:        "Weekly backups (paused)",
-		},
+// loadMore appends the next page of schedules, if there is one.
+func (sl *ScheduleList) loadMore() {
+	provider := sl.app.Provider()
+	if provider == nil || sl.nextPageToken == "" || sl.loading || sl.loadingMore {
+		return
 	}
-	sl.populateTable()
+
+	token := sl.nextPageToken
+	sl.loadingMore = true
+	sl.updatePanelTitle()
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		schedules, nextToken, err := provider.ListSchedules(ctx, sl.namespace, temporal.ListOptions{
+			PageSize:  schedulePageSize,
+			PageToken: token,
+		})
+
+		sl.app.JigApp().QueueUpdateDraw(func() {
+			sl.loadingMore = false
+			if sl.nextPageToken != token {
+				sl.updatePanelTitle()
+				return // The list was reloaded while this page was loading
+			}
+			if err != nil {
+				sl.updatePanelTitle()
+				sl.app.ShowToastError(fmt.Sprintf("Failed to load more schedules: %v", err))
+				return
+			}
+			sl.schedules = append(sl.schedules, schedules...)
+			sl.nextPageToken = nextToken
+			sl.populateTable()
+			sl.updatePanelTitle()
+		})
+	}()
+}
+
+func (sl *ScheduleList) updatePanelTitle() {
+	title := fmt.Sprintf("%s Schedules", theme.IconSchedule)
+	if sl.nextPageToken != "" {
+		loaded := fmt.Sprintf("loaded %s, more below", formatCount(int64(len(sl.schedules))))
+		if sl.loadingMore {
+			loaded = fmt.Sprintf("loaded %s, loading more", formatCount(int64(len(sl.schedules))))
+		}
+		title += fmt.Sprintf(" [%s]%s[-]", theme.TagFgMuted(), loaded)
+	}
+	sl.leftPanel.SetTitle(title)
 }
 
 func (sl *ScheduleList) populateTable() {
@@ -633,6 +653,12 @@ func (sl *ScheduleList) Start() {
 		case 'p':
 			sl.togglePreview()
 			return nil
+		case 'n': // New
+			sl.showCreateSchedule()
+			return nil
+		case 'e': // Edit
+			sl.showEditSchedule()
+			return nil
 		case 'P': // Pause/Unpause toggle
 			sl.showPauseConfirm()
 			return nil
@@ -659,6 +685,8 @@ func (sl *ScheduleList) Hints() []KeyHint {
 		{Key: "r", Description: "Refresh"},
 		{Key: "j/k", Description: "Navigate"},
 		{Key: "p", Description: "Preview"},
+		{Key: "n", Description: "New"},
+		{Key: "e", Description: "Edit"},
 		{Key: "P", Description: "Pause/Unpause"},
 		{Key: "t", Description: "Trigger"},
 		{Key: "D", Description: "Delete"},
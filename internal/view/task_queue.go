@@ -110,7 +110,6 @@ func (tq *TaskQueueView) RefreshTheme() {
 func (tq *TaskQueueView) loadData() {
 	provider := tq.app.Provider()
 	if provider == nil {
-		tq.loadMockQueues()
 		return
 	}
 
@@ -179,16 +178,6 @@ func (tq *TaskQueueView) showQueueError(err error) {
 	)
 }
 
-func (tq *TaskQueueView) loadMockQueues() {
-	tq.queues = []taskQueueEntry{
-		{Name: "order-tasks", Type: "Combined", PollerCount: 5, Backlog: 12},
-		{Name: "payment-tasks", Type: "Combined", PollerCount: 3, Backlog: 0},
-		{Name: "shipment-tasks", Type: "Combined", PollerCount: 2, Backlog: 5},
-		{Name: "notification-tasks", Type: "Combined", PollerCount: 2, Backlog: 0},
-	}
-	tq.populateQueueTable()
-}
-
 func (tq *TaskQueueView) populateQueueTable() {
 	// Preserve current selection
 	currentRow := tq.queueTable.SelectedRow()
@@ -253,7 +242,6 @@ func (tq *TaskQueueView) loadPollers(queueIndex int) {
 
 	provider := tq.app.Provider()
 	if provider == nil {
-		tq.loadMockPollers(queue)
 		return
 	}
 
@@ -300,18 +288,6 @@ func (tq *TaskQueueView) updateQueueInfo(queueIndex int, info *temporal.TaskQueu
 	tq.suppressSelect = false
 }
 
-func (tq *TaskQueueView) loadMockPollers(queue taskQueueEntry) {
-	now := time.Now()
-	tq.pollers = []temporal.Poller{
-		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-5 * time.Second), TaskQueueType: "Workflow"},
-		{Identity: "worker-1@host-001", LastAccessTime: now.Add(-3 * time.Second), TaskQueueType: "Activity"},
-		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-10 * time.Second), TaskQueueType: "Workflow"},
-		{Identity: "worker-2@host-002", LastAccessTime: now.Add(-2 * time.Second), TaskQueueType: "Activity"},
-		{Identity: "worker-3@host-003", LastAccessTime: now.Add(-1 * time.Second), TaskQueueType: "Activity"},
-	}
-	tq.populatePollerTable("")
-}
-
 func (tq *TaskQueueView) populatePollerTable(queueType string) {
 	tq.pollerTable.ClearRows()
 	tq.pollerTable.SetHeaders("IDENTITY", "TYPE", "LAST ACCESS")
@@ -122,6 +122,15 @@ func (tv *TimelineView) SetNodes(nodes []*temporal.EventTreeNode) {
 	}
 }
 
+// UpdateNodes rebuilds the lanes from nodes, keeping the selected lane, scroll and zoom.
+func (tv *TimelineView) UpdateNodes(nodes []*temporal.EventTreeNode) {
+	selected := tv.selectedLane
+	tv.SetNodes(nodes)
+	if selected < len(tv.lanes) {
+		tv.selectedLane = selected
+	}
+}
+
 // Draw renders the timeline view.
 // Colors are read dynamically at draw time.
 func (tv *TimelineView) Draw(screen tcell.Screen) {
@@ -579,15 +588,15 @@ func (tv *TimelineView) drawLegend(screen tcell.Screen, x, y, width int) {
 // barStyle returns the bar character and color for a status.
 func (tv *TimelineView) barStyle(status string) (rune, tcell.Color) {
 	switch status {
-	case "Running":
+	case "Running", "Accepted":
 		return '▓', theme.Warning()
 	case "Completed", "Fired":
 		return '█', theme.Success()
-	case "Failed", "TimedOut":
+	case "Failed", "TimedOut", "Rejected":
 		return '░', theme.Error()
 	case "Canceled", "Terminated":
 		return '▒', theme.Warning()
-	case "Scheduled", "Initiated", "Pending":
+	case "Scheduled", "Initiated", "Pending", "Admitted":
 		return '▒', theme.FgDim()
 	default:
 		return '▒', theme.Fg()
@@ -101,6 +101,48 @@ func (etv *EventTreeView) SetNodes(nodes []*temporal.EventTreeNode) {
 	}
 }
 
+// UpdateNodes re-renders the changed nodes in place and appends new ones, keeping
+// the current selection and expand state. nodes is the full updated node list.
+func (etv *EventTreeView) UpdateNodes(nodes, changed []*temporal.EventTreeNode) {
+	var selected any
+	if current := etv.GetCurrentNode(); current != nil {
+		selected = current.GetReference()
+	}
+
+	existing := make(map[*temporal.EventTreeNode]*tview.TreeNode)
+	for _, child := range etv.root.GetChildren() {
+		if ref, ok := child.GetReference().(*temporal.EventTreeNode); ok {
+			existing[ref] = child
+		}
+	}
+
+	for _, node := range changed {
+		treeNode, ok := existing[node]
+		if !ok {
+			etv.root.AddChild(etv.createTreeNode(node, 0))
+			continue
+		}
+		treeNode.SetText(etv.formatNodeText(node))
+		treeNode.SetColor(etv.statusColor(node.Status))
+		treeNode.ClearChildren()
+		for _, child := range node.Children {
+			treeNode.AddChild(etv.createTreeNode(child, 1))
+		}
+	}
+	etv.nodes = nodes
+
+	// Rebuilt children are new tree nodes; reselect by the node they display
+	if selected != nil {
+		etv.walkNodes(etv.root, func(node *tview.TreeNode) {
+			if node.GetReference() == selected {
+				etv.SetCurrentNode(node)
+			}
+		})
+	} else if children := etv.root.GetChildren(); len(children) > 0 {
+		etv.SetCurrentNode(children[0])
+	}
+}
+
 // createTreeNode recursively creates tview tree nodes from EventTreeNodes.
 func (etv *EventTreeView) createTreeNode(node *temporal.EventTreeNode, depth int) *tview.TreeNode {
 	// Build display text
@@ -148,11 +190,11 @@ func (etv *EventTreeView) formatNodeText(node *temporal.EventTreeNode) string {
 // statusIcon returns the icon for a node status.
 func (etv *EventTreeView) statusIcon(status string) string {
 	switch status {
-	case "Running":
+	case "Running", "Accepted":
 		return theme.IconRunning
 	case "Completed":
 		return theme.IconCompleted
-	case "Failed":
+	case "Failed", "Rejected":
 		return theme.IconFailed
 	case "Canceled":
 		return theme.IconCanceled
@@ -162,7 +204,7 @@ func (etv *EventTreeView) statusIcon(status string) string {
 		return theme.IconTimedOut
 	case "Fired":
 		return theme.IconCompleted
-	case "Scheduled", "Initiated", "Pending":
+	case "Scheduled", "Initiated", "Pending", "Admitted":
 		return theme.IconPending
 	default:
 		return theme.IconEvent
@@ -0,0 +1,142 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// checkVisibilityQuery parses a query and validates it against the namespace's
+// search attributes, once they have loaded.
+func (wl *WorkflowList) checkVisibilityQuery(query string) error {
+	q, err := temporal.ParseVisibilityQuery(query)
+	if err != nil {
+		return err
+	}
+	return q.Validate(wl.searchAttributes)
+}
+
+// loadSearchAttributes fetches the namespace's search attributes for completing and
+// validating queries, then calls done on the UI goroutine. They are fetched again
+// after an attribute is added or removed.
+func (wl *WorkflowList) loadSearchAttributes(done func()) {
+	provider := wl.app.Provider()
+	version := wl.app.searchAttributesVersion
+	if provider == nil || (wl.searchAttributes != nil && wl.searchAttributesVersion == version) {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		attrs, err := provider.ListSearchAttributes(ctx, wl.namespace)
+		if err != nil {
+			return // Queries are still parsed, just not checked against attributes
+		}
+
+		wl.app.JigApp().QueueUpdateDraw(func() {
+			wl.searchAttributes = attrs
+			wl.searchAttributesVersion = version
+			done()
+		})
+	}()
+}
+
+// querySuggestions adapts visibility query completions to the autocomplete input,
+// which replaces the text after the last space or parenthesis before the cursor.
+func (wl *WorkflowList) querySuggestions(text string, cursorPos int) []components.Suggestion {
+	runes := []rune(text)
+	if cursorPos > len(runes) {
+		cursorPos = len(runes)
+	}
+	upToCursor := string(runes[:cursorPos])
+
+	start, completions := temporal.CompleteVisibilityQuery(text, len(upToCursor), wl.searchAttributes, wl.knownQueryValues())
+	replaceFrom := strings.LastIndexAny(upToCursor, " ()") + 1
+	if replaceFrom > start {
+		return nil // A partial value with a space in it; the input would cut it in half
+	}
+
+	suggestions := make([]components.Suggestion, 0, len(completions))
+	for _, c := range completions {
+		suggestions = append(suggestions, components.Suggestion{
+			Text:        strings.TrimSpace(c.Text),
+			InsertText:  upToCursor[replaceFrom:start] + c.Text,
+			Description: c.Detail,
+			Category:    c.Category,
+		})
+	}
+	return suggestions
+}
+
+// knownQueryValues returns values seen in the loaded workflows, offered when completing
+// WorkflowType and TaskQueue conditions.
+func (wl *WorkflowList) knownQueryValues() map[string][]string {
+	var types, queues []string
+	for _, w := range wl.allWorkflows {
+		if w.Type != "" && !slices.Contains(types, w.Type) {
+			types = append(types, w.Type)
+		}
+		if w.TaskQueue != "" && !slices.Contains(queues, w.TaskQueue) {
+			queues = append(queues, w.TaskQueue)
+		}
+	}
+	slices.Sort(types)
+	slices.Sort(queues)
+	return map[string][]string{"WorkflowType": types, "TaskQueue": queues}
+}
+
+// queryHistory steps through previous queries: -1 for older, +1 for newer.
+// Stepping past the newest returns to an empty query.
+func (wl *WorkflowList) queryHistory(direction int) string {
+	if len(wl.searchHistory) == 0 {
+		return ""
+	}
+	switch {
+	case direction < 0 && wl.historyIndex == -1:
+		wl.historyIndex = len(wl.searchHistory) - 1
+	case direction < 0:
+		wl.historyIndex = max(wl.historyIndex-1, 0)
+	case wl.historyIndex == -1:
+		return ""
+	case wl.historyIndex+1 >= len(wl.searchHistory):
+		wl.historyIndex = -1
+		return ""
+	default:
+		wl.historyIndex++
+	}
+	return wl.searchHistory[wl.historyIndex]
+}
+
+// renderQueryCheck shows whether a query is valid, underlining the offending text when not.
+func renderQueryCheck(query string, err error) string {
+	if strings.TrimSpace(query) == "" {
+		return fmt.Sprintf("[%s]An empty query lists every workflow[-]", theme.TagFgDim())
+	}
+	if err == nil {
+		return fmt.Sprintf("[%s]%s Valid query[-]", theme.TagSuccess(), theme.IconCheck)
+	}
+
+	var qe *temporal.VisibilityQueryError
+	if !errors.As(err, &qe) {
+		return fmt.Sprintf("[%s]%s %s[-]", theme.TagError(), theme.IconError, tview.Escape(err.Error()))
+	}
+	start := min(qe.Start, len(query))
+	end := min(max(qe.End, start), len(query))
+	marked := fmt.Sprintf("[%s::u]%s[-::-]", theme.TagError(), tview.Escape(query[start:end]))
+	if start == end {
+		marked = fmt.Sprintf("[%s]◂[-]", theme.TagError()) // Nothing to underline at the end of the query
+	}
+	return fmt.Sprintf("[%s]%s[-]%s[%s]%s[-]\n[%s]%s %s[-]",
+		theme.TagFg(), tview.Escape(query[:start]), marked, theme.TagFg(), tview.Escape(query[end:]),
+		theme.TagError(), theme.IconError, tview.Escape(qe.Message))
+}
@@ -0,0 +1,35 @@
+package view
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/rivo/tview"
+)
+
+// renderSearchAttributes formats search attributes one per line, sorted by name,
+// with each value's type after it.
+func renderSearchAttributes(attrs map[string]temporal.SearchAttribute, indent string) string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(attrs)) {
+		sa := attrs[name]
+		fmt.Fprintf(&b, "\n%s[%s]%s[-] [%s]%s[-]", indent, theme.TagFgDim(), name, theme.TagFg(), tview.Escape(sa.String()))
+		if sa.Type != "" {
+			fmt.Fprintf(&b, " [%s](%s)[-]", theme.TagFgMuted(), sa.Type)
+		}
+	}
+	return b.String()
+}
+
+// renderMemo formats memo fields one per line, sorted by name, as their JSON.
+func renderMemo(memo map[string]string, indent string) string {
+	var b strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(memo)) {
+		fmt.Fprintf(&b, "\n%s[%s]%s[-] [%s]%s[-]", indent, theme.TagFgDim(), name, theme.TagFg(), tview.Escape(memo[name]))
+	}
+	return b.String()
+}
@@ -24,12 +24,18 @@ type WorkflowDetail struct {
 	events           []temporal.EnhancedHistoryEvent
 	leftFlex         *tview.Flex
 	workflowPanel    *components.Panel
+	pendingPanel     *components.Panel
 	eventDetailPanel *components.Panel
 	eventsPanel      *components.Panel
 	workflowView     *tview.TextView
+	pendingView      *tview.TextView
 	eventDetailView  *tview.TextView
 	eventTable       *components.Table
 	loading          bool
+	loader           historyLoader
+	partial          bool // Loading was stopped before the whole history was read
+	follower         historyFollower
+	followPending    bool // Follow once the loader finishes, so followed events can't skip its pages
 }
 
 // NewWorkflowDetail creates a new workflow detail view.
@@ -41,6 +47,8 @@ func NewWorkflowDetail(app *App, workflowID, runID string) *WorkflowDetail {
 		runID:      runID,
 		eventTable: components.NewTable(),
 	}
+	wd.loader.app = app
+	wd.follower.app = app
 	wd.setup()
 	return wd
 }
@@ -54,6 +62,12 @@ func (wd *WorkflowDetail) setup() {
 		SetTextAlign(tview.AlignLeft)
 	wd.workflowView.SetBackgroundColor(theme.Bg())
 
+	// Pending activities, children and execution config
+	wd.pendingView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetTextAlign(tview.AlignLeft)
+	wd.pendingView.SetBackgroundColor(theme.Bg())
+
 	// Event detail view
 	wd.eventDetailView = tview.NewTextView().
 		SetDynamicColors(true).
@@ -69,16 +83,20 @@ func (wd *WorkflowDetail) setup() {
 	wd.workflowPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Workflow", theme.IconWorkflow))
 	wd.workflowPanel.SetContent(wd.workflowView)
 
+	wd.pendingPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Pending", theme.IconPending))
+	wd.pendingPanel.SetContent(wd.pendingView)
+
 	wd.eventDetailPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Event Detail", theme.IconInfo))
 	wd.eventDetailPanel.SetContent(wd.eventDetailView)
 
 	wd.eventsPanel = components.NewPanel().SetTitle(fmt.Sprintf("%s Events", theme.IconEvent))
 	wd.eventsPanel.SetContent(wd.eventTable)
 
-	// Left side: workflow info + event detail stacked
+	// Left side: workflow info + pending work + event detail stacked
 	wd.leftFlex = tview.NewFlex().SetDirection(tview.FlexRow)
 	wd.leftFlex.SetBackgroundColor(theme.Bg())
 	wd.leftFlex.AddItem(wd.workflowPanel, 0, 1, false)
+	wd.leftFlex.AddItem(wd.pendingPanel, 0, 1, false)
 	wd.leftFlex.AddItem(wd.eventDetailPanel, 0, 1, false)
 
 	// Main layout: left stack + right events
@@ -111,6 +129,8 @@ func (wd *WorkflowDetail) RefreshTheme() {
 	// Update text views
 	wd.workflowView.SetBackgroundColor(bg)
 	wd.workflowView.SetTextColor(fg)
+	wd.pendingView.SetBackgroundColor(bg)
+	wd.pendingView.SetTextColor(fg)
 	wd.eventDetailView.SetBackgroundColor(bg)
 	wd.eventDetailView.SetTextColor(fg)
 
@@ -128,7 +148,54 @@ func (wd *WorkflowDetail) RefreshTheme() {
 func (wd *WorkflowDetail) loadData() {
 	provider := wd.app.Provider()
 	if provider == nil {
-		wd.loadMockData()
+		return
+	}
+
+	wd.reloadWorkflow()
+
+	// A follower would race the reload's pages, so it resumes once they are in
+	if wd.follower.Active() {
+		wd.follower.Stop()
+		wd.followPending = true
+	}
+
+	// Load events in parallel, showing each page as it arrives
+	wd.events = nil
+	wd.partial = false
+	wd.loader.Start(wd.workflowID, wd.runID, func(events []temporal.EnhancedHistoryEvent) {
+		wd.appendEvents(events)
+		wd.updateEventsTitle()
+	}, func(err error) {
+		if err != nil {
+			wd.followPending = false
+		} else if wd.followPending {
+			wd.startFollow()
+		}
+		wd.updateEventsTitle()
+		wd.app.JigApp().Menu().SetHints(wd.Hints())
+		if err != nil {
+			wd.app.ShowToastError(fmt.Sprintf("Failed to load events: %s", err.Error()))
+		}
+	})
+	wd.updateEventsTitle()
+}
+
+// stopLoading cancels a history load, keeping the events loaded so far.
+func (wd *WorkflowDetail) stopLoading() {
+	if !wd.loader.Active() {
+		return
+	}
+	wd.loader.Stop()
+	wd.partial = true
+	wd.followPending = false
+	wd.updateEventsTitle()
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+}
+
+// reloadWorkflow fetches the workflow execution and re-renders its summary.
+func (wd *WorkflowDetail) reloadWorkflow() {
+	provider := wd.app.Provider()
+	if provider == nil {
 		return
 	}
 
@@ -151,50 +218,11 @@ func (wd *WorkflowDetail) loadData() {
 			wd.app.JigApp().Menu().SetHints(wd.Hints())
 		})
 	}()
-
-	// Load events in parallel
-	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
-		defer cancel()
-
-		events, err := provider.GetEnhancedWorkflowHistory(ctx, wd.app.CurrentNamespace(), wd.workflowID, wd.runID)
-
-		wd.app.JigApp().QueueUpdateDraw(func() {
-			if err != nil {
-				return
-			}
-			wd.events = events
-			wd.populateEventTable()
-		})
-	}()
-}
-
-func (wd *WorkflowDetail) loadMockData() {
-	now := time.Now()
-	wd.workflow = &temporal.Workflow{
-		ID:        wd.workflowID,
-		RunID:     wd.runID,
-		Type:      "MockWorkflow",
-		Status:    "Running",
-		Namespace: wd.app.CurrentNamespace(),
-		TaskQueue: "mock-tasks",
-		StartTime: now.Add(-5 * time.Minute),
-	}
-	wd.events = []temporal.EnhancedHistoryEvent{
-		{ID: 1, Type: "WorkflowExecutionStarted", Time: now.Add(-5 * time.Minute), Details: "WorkflowType: MockWorkflow, TaskQueue: mock-tasks"},
-		{ID: 2, Type: "WorkflowTaskScheduled", Time: now.Add(-5 * time.Minute), Details: "TaskQueue: mock-tasks"},
-		{ID: 3, Type: "WorkflowTaskStarted", Time: now.Add(-5 * time.Minute), Details: "Identity: worker-1@host"},
-		{ID: 4, Type: "WorkflowTaskCompleted", Time: now.Add(-5 * time.Minute), Details: "ScheduledEventId: 2"},
-		{ID: 5, Type: "ActivityTaskScheduled", Time: now.Add(-4 * time.Minute), Details: "ActivityType: MockActivity, TaskQueue: mock-tasks", ActivityType: "MockActivity"},
-		{ID: 6, Type: "ActivityTaskStarted", Time: now.Add(-4 * time.Minute), Details: "Identity: worker-1@host, Attempt: 1", ActivityType: "MockActivity", ScheduledEventID: 5},
-		{ID: 7, Type: "ActivityTaskCompleted", Time: now.Add(-3 * time.Minute), Details: "ScheduledEventId: 5, Result: {success: true}", ActivityType: "MockActivity", ScheduledEventID: 5},
-	}
-	wd.render()
-	wd.populateEventTable()
 }
 
 func (wd *WorkflowDetail) showError(err error) {
 	wd.workflowView.SetText(fmt.Sprintf("\n [%s]Error: %s[-]", theme.TagError(), err.Error()))
+	wd.pendingView.SetText("")
 	wd.eventDetailView.SetText("")
 }
 
@@ -233,7 +261,33 @@ func (wd *WorkflowDetail) render() {
 		theme.TagFgDim(), theme.TagFg(), w.TaskQueue,
 		theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.RunID, 25),
 	)
+
+	if w.ParentID != nil {
+		workflowText += fmt.Sprintf("\n[%s::b]Parent[-:-:-]       [%s]%s[-]", theme.TagFgDim(), theme.TagFg(), truncateStr(*w.ParentID, 40))
+	}
+
+	// Runs linked by continue-as-new, retry, cron or reset
+	if w.PrevRunID != "" {
+		workflowText += fmt.Sprintf("\n[%s::b]Prev Run[-:-:-]     [%s]%s[-]", theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.PrevRunID, 25))
+	}
+	if w.NextRunID != "" {
+		workflowText += fmt.Sprintf("\n[%s::b]Next Run[-:-:-]     [%s]%s[-]", theme.TagFgDim(), theme.TagFgDim(), truncateStr(w.NextRunID, 25))
+	}
+
+	if len(w.SearchAttributes) > 0 {
+		workflowText += fmt.Sprintf("\n\n[%s::b]Search Attributes[-:-:-]", theme.TagFgDim()) + renderSearchAttributes(w.SearchAttributes, "  ")
+	}
+	if len(w.Memo) > 0 {
+		workflowText += fmt.Sprintf("\n\n[%s::b]Memo[-:-:-]", theme.TagFgDim()) + renderMemo(w.Memo, "  ")
+	}
 	wd.workflowView.SetText(workflowText)
+
+	title := fmt.Sprintf("%s Pending", theme.IconPending)
+	if n := pendingCount(w); n > 0 {
+		title = fmt.Sprintf("%s Pending (%d)", theme.IconPending, n)
+	}
+	wd.pendingPanel.SetTitle(title)
+	wd.pendingView.SetText(renderPending(w))
 }
 
 func (wd *WorkflowDetail) updateEventDetail(ev temporal.EnhancedHistoryEvent) {
@@ -473,15 +527,7 @@ func (wd *WorkflowDetail) populateEventTable() {
 	wd.eventTable.SetHeaders("ID", "TIME", "TYPE", "NAME")
 
 	for _, ev := range wd.events {
-		icon := eventIcon(ev.Type)
-		color := eventColor(ev.Type)
-		name := getEventNameDetail(&ev)
-		wd.eventTable.AddRowWithColor(color,
-			fmt.Sprintf("%d", ev.ID),
-			ev.Time.Format("15:04:05"),
-			icon+" "+truncateStr(ev.Type, 30),
-			name,
-		)
+		wd.addEventRow(ev)
 	}
 
 	if wd.eventTable.RowCount() > 0 {
@@ -498,6 +544,107 @@ func (wd *WorkflowDetail) populateEventTable() {
 	}
 }
 
+// addEventRow appends a row for an event to the events table.
+func (wd *WorkflowDetail) addEventRow(ev temporal.EnhancedHistoryEvent) {
+	icon := eventIcon(ev.Type)
+	color := eventColor(ev.Type)
+	name := getEventNameDetail(&ev)
+	wd.eventTable.AddRowWithColor(color,
+		fmt.Sprintf("%d", ev.ID),
+		ev.Time.Format("15:04:05"),
+		icon+" "+truncateStr(ev.Type, 30),
+		name,
+	)
+}
+
+// toggleFollow starts or stops appending new events as the workflow runs.
+func (wd *WorkflowDetail) toggleFollow() {
+	if wd.following() {
+		wd.follower.Stop()
+		wd.followPending = false
+		wd.updateEventsTitle()
+		wd.app.JigApp().Menu().SetHints(wd.Hints())
+		return
+	}
+	if wd.workflow != nil && wd.workflow.Status != "Running" {
+		wd.app.ShowToastWarning("Workflow is not running")
+		return
+	}
+	if wd.loader.Active() {
+		wd.followPending = true
+		wd.updateEventsTitle()
+		wd.app.JigApp().Menu().SetHints(wd.Hints())
+		return
+	}
+	wd.startFollow()
+}
+
+// following reports whether new events are followed or will be once loading finishes.
+func (wd *WorkflowDetail) following() bool {
+	return wd.follower.Active() || wd.followPending
+}
+
+// startFollow follows events after the last one loaded.
+func (wd *WorkflowDetail) startFollow() {
+	wd.followPending = false
+	var lastEventID int64
+	if n := len(wd.events); n > 0 {
+		lastEventID = wd.events[n-1].ID
+	}
+	wd.follower.Start(wd.workflowID, wd.runID, lastEventID, wd.appendEvents, func(err error) {
+		wd.updateEventsTitle()
+		if err != nil {
+			wd.app.JigApp().Menu().SetHints(wd.Hints())
+			wd.app.ShowToastError(fmt.Sprintf("Follow stopped: %s", err.Error()))
+			return
+		}
+		// Pick up the final status and close time
+		wd.reloadWorkflow()
+		wd.app.ShowToastInfo("Workflow closed, stopped following")
+	})
+	wd.updateEventsTitle()
+	wd.app.JigApp().Menu().SetHints(wd.Hints())
+}
+
+// appendEvents adds newly arrived events to the table, keeping the selection.
+// The workflow summary doesn't depend on events and is rendered once it loads.
+func (wd *WorkflowDetail) appendEvents(events []temporal.EnhancedHistoryEvent) {
+	// The first events replace whatever the table showed before a refresh
+	if len(wd.events) == 0 {
+		wd.events = events
+		wd.populateEventTable()
+		return
+	}
+
+	var lastEventID int64
+	if n := len(wd.events); n > 0 {
+		lastEventID = wd.events[n-1].ID
+	}
+	for _, ev := range events {
+		// A refresh may already have loaded this event
+		if ev.ID <= lastEventID {
+			continue
+		}
+		wd.events = append(wd.events, ev)
+		wd.addEventRow(ev)
+	}
+}
+
+// updateEventsTitle shows load progress and whether new events are being followed.
+func (wd *WorkflowDetail) updateEventsTitle() {
+	title := fmt.Sprintf("%s Events", theme.IconEvent)
+	switch {
+	case wd.loader.Active():
+		title += fmt.Sprintf(" %s Loading %s", theme.IconPending, wd.loader.Progress())
+	case wd.partial:
+		title += fmt.Sprintf(" %s Partial: %s", theme.IconWarning, wd.loader.Progress())
+	}
+	if wd.following() {
+		title += fmt.Sprintf(" %s Following", theme.IconRunning)
+	}
+	wd.eventsPanel.SetTitle(title)
+}
+
 // getEventNameDetail returns the activity type, timer ID, or child workflow type for an event.
 func getEventNameDetail(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ActivityType != "" {
@@ -509,6 +656,9 @@ func getEventNameDetail(ev *temporal.EnhancedHistoryEvent) string {
 	if ev.ChildWorkflowType != "" {
 		return ev.ChildWorkflowType
 	}
+	if ev.UpdateName != "" {
+		return "Update: " + ev.UpdateName
+	}
 	return ""
 }
 
@@ -524,6 +674,12 @@ func (wd *WorkflowDetail) Start() {
 		case 'r':
 			wd.loadData()
 			return nil
+		case 'x':
+			wd.stopLoading()
+			return nil
+		case 'F':
+			wd.toggleFollow()
+			return nil
 		case 'e':
 			// Navigate to event history/graph view
 			wd.app.NavigateToEvents(wd.workflowID, wd.runID)
@@ -549,12 +705,37 @@ func (wd *WorkflowDetail) Start() {
 		case 'R':
 			wd.showResetSelector()
 			return nil
+		case '[':
+			if wd.workflow != nil {
+				wd.openRun(wd.workflow.PrevRunID)
+			}
+			return nil
+		case ']':
+			if wd.workflow != nil {
+				wd.openRun(wd.workflow.NextRunID)
+			}
+			return nil
+		case 'C':
+			wd.showRunChain()
+			return nil
+		case 'p':
+			wd.openParent()
+			return nil
+		case 'A':
+			wd.showActivityPicker()
+			return nil
 		case 'Q':
 			wd.showQueryInput()
 			return nil
+		case 'u':
+			wd.showUpdateInput()
+			return nil
 		case 'i':
 			wd.showIOModal()
 			return nil
+		case 'E':
+			wd.app.showHistoryExport(wd.workflowID, wd.runID)
+			return nil
 		}
 		return event
 	})
@@ -564,6 +745,9 @@ func (wd *WorkflowDetail) Start() {
 // Stop is called when the view is deactivated.
 func (wd *WorkflowDetail) Stop() {
 	wd.eventTable.SetInputCapture(nil)
+	wd.loader.Stop()
+	wd.follower.Stop()
+	wd.followPending = false
 }
 
 // Hints returns keybinding hints for this view.
@@ -574,21 +758,44 @@ func (wd *WorkflowDetail) Hints() []KeyHint {
 		{Key: "d", Description: "Detail"},
 		{Key: "y", Description: "Yank"},
 		{Key: "r", Description: "Refresh"},
+		{Key: "E", Description: "Export History"},
+		{Key: "C", Description: "Run Chain"},
 		{Key: "j/k", Description: "Navigate"},
 	}
+	if wd.workflow != nil && wd.workflow.ParentID != nil {
+		hints = append(hints, KeyHint{Key: "p", Description: "Parent"})
+	}
+	if wd.workflow != nil && wd.workflow.PrevRunID != "" {
+		hints = append(hints, KeyHint{Key: "[", Description: "Prev Run"})
+	}
+	if wd.workflow != nil && wd.workflow.NextRunID != "" {
+		hints = append(hints, KeyHint{Key: "]", Description: "Next Run"})
+	}
+	if wd.loader.Active() {
+		hints = append(hints, KeyHint{Key: "x", Description: "Stop Loading"})
+	}
 
 	// Only show mutation hints if workflow is running
 	if wd.workflow != nil && wd.workflow.Status == "Running" {
+		followHint := KeyHint{Key: "F", Description: "Follow"}
+		if wd.following() {
+			followHint.Description = "Stop Following"
+		}
 		hints = append(hints,
+			followHint,
 			KeyHint{Key: "c", Description: "Cancel"},
 			KeyHint{Key: "X", Description: "Terminate"},
 			KeyHint{Key: "s", Description: "Signal"},
+			KeyHint{Key: "u", Description: "Update"},
 			KeyHint{Key: "Q", Description: "Query"},
 		)
+		if len(wd.workflow.PendingActivities) > 0 {
+			hints = append(hints, KeyHint{Key: "A", Description: "Activities"})
+		}
 	}
 
 	// Reset is available for completed/failed workflows
-	if wd.workflow != nil && (wd.workflow.Status == "Completed" || wd.workflow.Status == "Failed" || wd.workflow.Status == "Terminated" || wd.workflow.Status == "Canceled") {
+	if wd.workflow != nil && (wd.workflow.Status == "Completed" || wd.workflow.Status == "Failed" || wd.workflow.Status == "Terminated" || wd.workflow.Status == "Canceled" || wd.workflow.Status == temporal.StatusContinuedAsNew) {
 		hints = append(hints, KeyHint{Key: "R", Description: "Reset"})
 	}
 
@@ -949,6 +1156,92 @@ func (wd *WorkflowDetail) executeSignalWorkflow(signalName, input string) {
 	}()
 }
 
+func (wd *WorkflowDetail) showUpdateInput() {
+	modal := components.NewModal(components.ModalConfig{
+		Title:    fmt.Sprintf("%s Update Workflow", theme.IconEdit),
+		Width:    70,
+		Height:   18,
+		Backdrop: true,
+	})
+
+	form := components.NewForm()
+	form.AddTextField("updateName", "Update Name", "")
+	form.AddTextField("input", "Input (JSON, optional)", "")
+	form.AddTextField("updateID", "Update ID (optional)", "")
+
+	submit := func(values map[string]any) {
+		updateName := values["updateName"].(string)
+		if updateName == "" {
+			return // Require update name
+		}
+		input := values["input"].(string)
+		if input != "" && !json.Valid([]byte(input)) {
+			wd.app.ShowToastError("Input must be valid JSON")
+			return
+		}
+		wd.closeModal("update-input")
+		wd.executeUpdateWorkflow(temporal.UpdateWorkflowRequest{
+			WorkflowID: wd.workflowID,
+			RunID:      wd.runID,
+			UpdateName: updateName,
+			UpdateID:   values["updateID"].(string),
+			Input:      []byte(input),
+		})
+	}
+	form.SetOnSubmit(submit)
+	form.SetOnCancel(func() {
+		wd.closeModal("update-input")
+	})
+
+	modal.SetContent(form)
+	modal.SetHints([]components.KeyHint{
+		{Key: "Tab", Description: "Next field"},
+		{Key: "Enter", Description: "Send update"},
+		{Key: "Esc", Description: "Cancel"},
+	})
+	modal.SetOnSubmit(func() {
+		submit(form.GetValues())
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("update-input")
+	})
+
+	wd.app.JigApp().Pages().AddPage("update-input", modal, true, true)
+	wd.app.JigApp().SetFocus(form)
+}
+
+func (wd *WorkflowDetail) executeUpdateWorkflow(req temporal.UpdateWorkflowRequest) {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		result, err := provider.UpdateWorkflow(ctx, wd.app.CurrentNamespace(), req)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.showFailureModal(fmt.Sprintf("%s Update Failed: %s", theme.IconError, req.UpdateName), "update-error", "Error sending update:", err.Error())
+				return
+			}
+			wd.loadData() // Refresh to show update events
+			if result.Failure != "" {
+				wd.showFailureModal(fmt.Sprintf("%s Update Failed: %s", theme.IconError, req.UpdateName), "update-error", "Update handler failed:", result.Failure)
+				return
+			}
+			if result.Stage != "Completed" {
+				ShowInfoModal(wd.app.JigApp(), "Update Pending",
+					fmt.Sprintf("Update %s reached stage %s before the wait timed out.", result.UpdateID, result.Stage))
+				return
+			}
+			wd.showResultModal(fmt.Sprintf("%s Update Result: %s", theme.IconInfo, req.UpdateName), "update-result", result.Result)
+		})
+	}()
+}
+
 func (wd *WorkflowDetail) showResetSelector() {
 	provider := wd.app.Provider()
 	if provider == nil {
@@ -1321,8 +1614,13 @@ func (wd *WorkflowDetail) executeQuery(queryType, args string) {
 }
 
 func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
+	wd.showResultModal(fmt.Sprintf("%s Query Result: %s", theme.IconInfo, queryType), "query-result", result)
+}
+
+// showResultModal shows a scrollable, copyable JSON result on the named page.
+func (wd *WorkflowDetail) showResultModal(title, page, result string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:     fmt.Sprintf("%s Query Result: %s", theme.IconInfo, queryType),
+		Title:     title,
 		Width:     0,
 		Height:    0,
 		MinWidth:  80,
@@ -1349,7 +1647,7 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 	resultView.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEscape:
-			wd.closeModal("query-result")
+			wd.closeModal(page)
 			return nil
 		case tcell.KeyDown:
 			row, col := resultView.GetScrollOffset()
@@ -1393,7 +1691,7 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 				}()
 				return nil
 			case 'q':
-				wd.closeModal("query-result")
+				wd.closeModal(page)
 				return nil
 			}
 		}
@@ -1407,16 +1705,21 @@ func (wd *WorkflowDetail) showQueryResult(queryType, result string) {
 		{Key: "Esc", Description: "Close"},
 	})
 	modal.SetOnCancel(func() {
-		wd.closeModal("query-result")
+		wd.closeModal(page)
 	})
 
-	wd.app.JigApp().Pages().AddPage("query-result", modal, true, true)
+	wd.app.JigApp().Pages().AddPage(page, modal, true, true)
 	wd.app.JigApp().SetFocus(resultView)
 }
 
 func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
+	wd.showFailureModal(fmt.Sprintf("%s Query Failed: %s", theme.IconError, queryType), "query-error", "Error executing query:", errMsg)
+}
+
+// showFailureModal shows an error message under a heading on the named page.
+func (wd *WorkflowDetail) showFailureModal(title, page, heading, errMsg string) {
 	modal := components.NewModal(components.ModalConfig{
-		Title:    fmt.Sprintf("%s Query Failed: %s", theme.IconError, queryType),
+		Title:    title,
 		Width:    60,
 		Height:   10,
 		Backdrop: true,
@@ -1426,21 +1729,21 @@ func (wd *WorkflowDetail) showQueryError(queryType, errMsg string) {
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft)
 	errorText.SetBackgroundColor(theme.Bg())
-	errorText.SetText(fmt.Sprintf("[%s]Error executing query:[-]\n\n[%s]%s[-]",
-		theme.TagError(), theme.TagFg(), errMsg))
+	errorText.SetText(fmt.Sprintf("[%s]%s[-]\n\n[%s]%s[-]",
+		theme.TagError(), heading, theme.TagFg(), errMsg))
 
 	modal.SetContent(errorText)
 	modal.SetHints([]components.KeyHint{
 		{Key: "Enter/Esc", Description: "Close"},
 	})
 	modal.SetOnSubmit(func() {
-		wd.closeModal("query-error")
+		wd.closeModal(page)
 	})
 	modal.SetOnCancel(func() {
-		wd.closeModal("query-error")
+		wd.closeModal(page)
 	})
 
-	wd.app.JigApp().Pages().AddPage("query-error", modal, true, true)
+	wd.app.JigApp().Pages().AddPage(page, modal, true, true)
 }
 
 // getSelectedEventDetails returns the details for the currently selected event.
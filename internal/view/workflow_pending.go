@@ -0,0 +1,181 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+)
+
+// pendingCount returns how many things a workflow is waiting on.
+func pendingCount(w *temporal.Workflow) int {
+	n := len(w.PendingActivities) + len(w.PendingChildren) + len(w.PendingNexusOperations)
+	if w.PendingWorkflowTask != nil {
+		n++
+	}
+	return n
+}
+
+// renderPending formats what a workflow is waiting on, followed by its execution config.
+func renderPending(w *temporal.Workflow) string {
+	now := time.Now()
+	var b strings.Builder
+
+	section := func(title string, count int) {
+		fmt.Fprintf(&b, "\n[%s::b]%s[-:-:-]", theme.TagAccent(), title)
+		if count > 0 {
+			fmt.Fprintf(&b, " [%s](%d)[-]", theme.TagFgDim(), count)
+		}
+		b.WriteString("\n")
+	}
+	row := func(label, value string) {
+		fmt.Fprintf(&b, "  [%s]%-14s[-] [%s]%s[-]\n", theme.TagFgDim(), label, theme.TagFg(), value)
+	}
+	failureRow := func(label, value string) {
+		fmt.Fprintf(&b, "  [%s]%-14s[-] [%s]%s[-]\n", theme.TagFgDim(), label, theme.TagError(), value)
+	}
+
+	if pendingCount(w) == 0 {
+		fmt.Fprintf(&b, "\n [%s]Nothing pending[-]\n", theme.TagFgDim())
+	}
+
+	if wt := w.PendingWorkflowTask; wt != nil {
+		section("Workflow Task", 0)
+		row("State", wt.State)
+		row("Attempt", fmt.Sprintf("%d", wt.Attempt))
+		if wt.OriginalScheduledTime != nil {
+			row("Scheduled", formatRelativeTime(now, *wt.OriginalScheduledTime))
+		} else if wt.ScheduledTime != nil {
+			row("Scheduled", formatRelativeTime(now, *wt.ScheduledTime))
+		}
+		if wt.StartedTime != nil {
+			row("Started", formatRelativeTime(now, *wt.StartedTime))
+		}
+	}
+
+	if len(w.PendingActivities) > 0 {
+		section("Activities", len(w.PendingActivities))
+		for _, pa := range w.PendingActivities {
+			fmt.Fprintf(&b, " %s [%s]%s[-] [%s]#%s[-] [%s]%s[-]\n",
+				theme.IconActivity, theme.TagFg(), pa.ActivityType,
+				theme.TagFgDim(), pa.ActivityID,
+				pendingStateColor(pa.State), pa.State)
+
+			maxAttempts := "unlimited"
+			if pa.MaximumAttempts > 0 {
+				maxAttempts = fmt.Sprintf("%d", pa.MaximumAttempts)
+			}
+			row("Attempt", fmt.Sprintf("%d of %s", pa.Attempt, maxAttempts))
+			if pa.ScheduledTime != nil {
+				row("Scheduled", formatRelativeTime(now, *pa.ScheduledTime))
+			}
+			if pa.LastStartedTime != nil {
+				row("Last Started", formatRelativeTime(now, *pa.LastStartedTime))
+			}
+			if pa.LastHeartbeatTime != nil {
+				row("Heartbeat", formatRelativeTime(now, *pa.LastHeartbeatTime))
+			}
+			if pa.HeartbeatDetails != "" {
+				row("Details", truncateStr(pa.HeartbeatDetails, 60))
+			}
+			if pa.LastFailure != "" {
+				failureRow("Last Failure", truncateStr(pa.LastFailure, 80))
+			}
+			if pa.NextAttemptScheduleTime != nil {
+				row("Next Attempt", formatUntil(now, *pa.NextAttemptScheduleTime))
+			}
+			if pa.CurrentRetryInterval > 0 {
+				row("Retry Interval", pa.CurrentRetryInterval.String())
+			}
+			if pa.ExpirationTime != nil {
+				row("Expires", formatUntil(now, *pa.ExpirationTime))
+			}
+			if pa.LastWorkerIdentity != "" {
+				row("Worker", pa.LastWorkerIdentity)
+			}
+		}
+	}
+
+	if len(w.PendingChildren) > 0 {
+		section("Child Workflows", len(w.PendingChildren))
+		for _, pc := range w.PendingChildren {
+			fmt.Fprintf(&b, " %s [%s]%s[-]\n", theme.IconWorkflow, theme.TagFg(), pc.WorkflowType)
+			row("Workflow ID", pc.WorkflowID)
+			runID := pc.RunID
+			if runID == "" {
+				runID = "not started"
+			}
+			row("Run ID", runID)
+			if pc.ParentClosePolicy != "" && pc.ParentClosePolicy != "Unspecified" {
+				row("Parent Close", pc.ParentClosePolicy)
+			}
+		}
+	}
+
+	if len(w.PendingNexusOperations) > 0 {
+		section("Nexus Operations", len(w.PendingNexusOperations))
+		for _, op := range w.PendingNexusOperations {
+			fmt.Fprintf(&b, " %s [%s]%s/%s[-] [%s]%s[-]\n",
+				theme.IconBolt, theme.TagFg(), op.Service, op.Operation,
+				pendingStateColor(op.State), op.State)
+			row("Endpoint", op.Endpoint)
+			row("Attempt", fmt.Sprintf("%d", op.Attempt))
+			if op.ScheduledTime != nil {
+				row("Scheduled", formatRelativeTime(now, *op.ScheduledTime))
+			}
+			if op.ScheduleToCloseTimeout > 0 {
+				row("Timeout", op.ScheduleToCloseTimeout.String())
+			}
+			if op.NextAttemptScheduleTime != nil {
+				row("Next Attempt", formatUntil(now, *op.NextAttemptScheduleTime))
+			}
+			if op.BlockedReason != "" {
+				failureRow("Blocked", op.BlockedReason)
+			}
+			if op.LastAttemptFailure != "" {
+				failureRow("Last Failure", truncateStr(op.LastAttemptFailure, 80))
+			}
+		}
+	}
+
+	if cfg := w.Config; cfg != nil {
+		section("Execution Config", 0)
+		row("Task Queue", cfg.TaskQueue)
+		row("Exec Timeout", formatTimeout(cfg.ExecutionTimeout))
+		row("Run Timeout", formatTimeout(cfg.RunTimeout))
+		row("Task Timeout", formatTimeout(cfg.WorkflowTaskTimeout))
+	}
+
+	return b.String()
+}
+
+// pendingStateColor returns the color tag for a pending activity or Nexus operation state.
+func pendingStateColor(state string) string {
+	switch state {
+	case "Started":
+		return theme.TagInfo()
+	case "BackingOff", "Blocked", "CancelRequested", "Paused", "PauseRequested":
+		return theme.TagWarning()
+	default:
+		return theme.TagFgDim()
+	}
+}
+
+// formatUntil formats a time relative to now, in either direction.
+func formatUntil(now, t time.Time) string {
+	d := t.Sub(now).Round(time.Second)
+	if d < 0 {
+		return formatRelativeTime(now, t)
+	}
+	return "in " + d.String()
+}
+
+// formatTimeout formats a workflow timeout, where zero means no limit.
+func formatTimeout(d time.Duration) string {
+	if d == 0 {
+		return "unlimited"
+	}
+	return d.String()
+}
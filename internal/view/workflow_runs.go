@@ -0,0 +1,159 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/atterpac/jig/components"
+	"github.com/atterpac/jig/theme"
+	"github.com/galaxy-io/tempo/internal/temporal"
+	"github.com/gdamore/tcell/v2"
+)
+
+// runChainPageSize is the page size used when listing every run of a workflow ID.
+const runChainPageSize = 100
+
+// openRun replaces this view with another run of the same workflow.
+func (wd *WorkflowDetail) openRun(runID string) {
+	if runID == "" || runID == wd.runID {
+		return
+	}
+	wd.app.JigApp().Pages().Replace(NewWorkflowDetail(wd.app, wd.workflowID, runID))
+}
+
+// openParent pushes the detail view of the run that started this child workflow.
+func (wd *WorkflowDetail) openParent() {
+	if wd.workflow == nil || wd.workflow.ParentID == nil {
+		return
+	}
+	wd.app.NavigateToWorkflowDetail(*wd.workflow.ParentID, wd.workflow.ParentRunID)
+}
+
+// showRunChain lists every run of this workflow ID, including runs started by
+// continue-as-new, retries, cron and resets.
+func (wd *WorkflowDetail) showRunChain() {
+	provider := wd.app.Provider()
+	if provider == nil {
+		return
+	}
+	namespace := wd.app.CurrentNamespace()
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		runs, err := listWorkflowRuns(ctx, provider, namespace, wd.workflowID)
+
+		wd.app.JigApp().QueueUpdateDraw(func() {
+			if err != nil {
+				wd.app.ShowToastError(fmt.Sprintf("Failed to list runs: %s", err.Error()))
+				return
+			}
+			wd.showRunChainPicker(runs)
+		})
+	}()
+}
+
+// listWorkflowRuns returns every run of a workflow ID, newest first.
+func listWorkflowRuns(ctx context.Context, provider temporal.Provider, namespace, workflowID string) ([]temporal.Workflow, error) {
+	opts := temporal.ListOptions{
+		PageSize: runChainPageSize,
+		Query:    fmt.Sprintf("WorkflowId = %s", temporal.QuoteVisibilityValue(workflowID)),
+	}
+
+	var runs []temporal.Workflow
+	for {
+		page, nextToken, err := provider.ListWorkflows(ctx, namespace, opts)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, page...)
+		if nextToken == "" {
+			return runs, nil
+		}
+		opts.PageToken = nextToken
+	}
+}
+
+func (wd *WorkflowDetail) showRunChainPicker(runs []temporal.Workflow) {
+	modal := components.NewModal(components.ModalConfig{
+		Title:     fmt.Sprintf("%s Runs of %s", theme.IconHistory, truncateStr(wd.workflowID, 50)),
+		Width:     110,
+		Height:    24,
+		MinHeight: 12,
+		Backdrop:  true,
+	})
+
+	table := components.NewTable()
+	table.SetHeaders("RUN ID", "STATUS", "STARTED", "CLOSED", "")
+	table.SetBackgroundColor(theme.Bg())
+
+	selected := 0
+	for i, run := range runs {
+		// Mark how each run relates to the one being viewed
+		var link string
+		if wd.workflow != nil {
+			switch run.RunID {
+			case wd.workflow.RunID:
+				link = "current"
+				selected = i
+			case wd.workflow.PrevRunID:
+				link = "previous"
+			case wd.workflow.NextRunID:
+				link = "next"
+			case wd.workflow.FirstRunID:
+				link = "first"
+			}
+		}
+
+		closed := "-"
+		if run.EndTime != nil {
+			closed = run.EndTime.Format("2006-01-02 15:04:05")
+		}
+		table.AddRowWithColor(theme.StatusColor(run.Status),
+			run.RunID,
+			theme.StatusIcon(run.Status)+" "+run.Status,
+			run.StartTime.Format("2006-01-02 15:04:05"),
+			closed,
+			link,
+		)
+	}
+	if len(runs) > 0 {
+		table.SelectRow(selected)
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Key() {
+		case tcell.KeyEnter:
+			row := table.SelectedRow()
+			if row >= 0 && row < len(runs) {
+				wd.closeModal("run-chain-picker")
+				wd.openRun(runs[row].RunID)
+			}
+			return nil
+		case tcell.KeyEscape:
+			wd.closeModal("run-chain-picker")
+			return nil
+		case tcell.KeyRune:
+			if event.Rune() == 'q' {
+				wd.closeModal("run-chain-picker")
+				return nil
+			}
+		}
+		return event
+	})
+
+	modal.SetContent(table)
+	modal.SetHints([]components.KeyHint{
+		{Key: "j/k", Description: "Navigate"},
+		{Key: "Enter", Description: "Open Run"},
+		{Key: "Esc", Description: "Close"},
+	})
+	modal.SetOnCancel(func() {
+		wd.closeModal("run-chain-picker")
+	})
+
+	wd.app.JigApp().Pages().AddPage("run-chain-picker", modal, true, true)
+	wd.app.JigApp().SetFocus(table)
+}